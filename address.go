@@ -0,0 +1,79 @@
+package alex
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+)
+
+// Address represents a network address split into its host and numeric port.
+// It is used internally to validate "host:port" strings and is exposed through
+// the ParsedAddr accessors of the configs that carry such strings.
+type Address struct {
+	Host string // Host is the host name or IP address (IPv6 literals are stored without brackets).
+	Port int    // Port is the numeric port in the range 1-65535.
+}
+
+// ParseAddress parses a "host:port" string into an Address.
+// Bracketed IPv6 literals such as "[::1]:6379" are supported, while a bare IPv6 address
+// such as "::1" is rejected as missing a port.
+//
+// Parameters:
+//   - s: The "host:port" string to parse
+//
+// Returns:
+//   - Address: The parsed address
+//   - error: An error if the string has no port or the port is not a number between 1 and 65535
+//
+// Example:
+//
+//	addr, err := ParseAddress("localhost:6379")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(addr.Host, addr.Port)
+func ParseAddress(s string) (Address, error) {
+	host, port, err := net.SplitHostPort(s)
+	if err != nil {
+		return Address{}, err
+	}
+	if port == "" {
+		return Address{}, errors.New("missing port")
+	}
+	n, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || n == 0 {
+		return Address{}, fmt.Errorf("port %q must be a number between 1 and 65535", port)
+	}
+	return Address{Host: host, Port: int(n)}, nil
+}
+
+// String returns the address in "host:port" form, bracketing IPv6 hosts.
+//
+// Returns:
+//   - string: The formatted address
+func (a Address) String() string {
+	return net.JoinHostPort(a.Host, strconv.Itoa(a.Port))
+}
+
+// escapeUserinfo returns the userinfo part of a connection URL, with the same escaping as
+// url.UserPassword(...).String(), so that every URL or DSN generator escapes credentials
+// containing reserved characters such as "@", ":", "/", or "%" consistently.
+// The password is omitted when empty, and an empty string is returned when both are empty.
+//
+// Parameters:
+//   - user: The username, possibly empty
+//   - pass: The password, possibly empty
+//
+// Returns:
+//   - string: The escaped "user:pass" (or "user") userinfo, without the trailing "@"
+func escapeUserinfo(user, pass string) string {
+	switch {
+	case pass != "":
+		return url.UserPassword(user, pass).String()
+	case user != "":
+		return url.User(user).String()
+	}
+	return ""
+}
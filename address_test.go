@@ -0,0 +1,96 @@
+package alex
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Address
+	}{
+		{"localhost:6379", Address{Host: "localhost", Port: 6379}},
+		{"10.0.0.1:1", Address{Host: "10.0.0.1", Port: 1}},
+		{"[::1]:65535", Address{Host: "::1", Port: 65535}},
+	}
+	for _, tt := range tests {
+		got, err := ParseAddress(tt.in)
+		if err != nil {
+			t.Errorf("ParseAddress(%q) error = %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseAddress(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+		if got.String() != tt.in {
+			t.Errorf("ParseAddress(%q).String() = %q, want the input back", tt.in, got.String())
+		}
+	}
+}
+
+func TestParseAddressInvalid(t *testing.T) {
+	for _, in := range []string{"localhost", "localhost:", "localhost:0", "localhost:65536", "localhost:-1", "localhost:http", "::1", ""} {
+		if got, err := ParseAddress(in); err == nil {
+			t.Errorf("ParseAddress(%q) = %+v, want an error", in, got)
+		}
+	}
+}
+
+func TestParsedAddrAccessors(t *testing.T) {
+	redis, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("[::1]:6380"))
+	if err != nil {
+		t.Fatalf("NewRedisConfig() error = %v", err)
+	}
+	addr, err := redis.ParsedAddr()
+	if err != nil || addr != (Address{Host: "::1", Port: 6380}) {
+		t.Errorf("ParsedAddr() = %+v, %v, want ::1 port 6380", addr, err)
+	}
+
+	sentinel, err := NewRedisSentinelConfig(NewRedisSentinelOptions().SetMasterName("mymaster").SetSentinelAddrs("host1:26379", "host2:26380"))
+	if err != nil {
+		t.Fatalf("NewRedisSentinelConfig() error = %v", err)
+	}
+	addrs, err := sentinel.ParsedSentinelAddrs()
+	if err != nil {
+		t.Fatalf("ParsedSentinelAddrs() error = %v", err)
+	}
+	if len(addrs) != 2 || addrs[1] != (Address{Host: "host2", Port: 26380}) {
+		t.Errorf("ParsedSentinelAddrs() = %+v, want host1:26379 and host2:26380", addrs)
+	}
+}
+
+func TestEscapeUserinfo(t *testing.T) {
+	tests := []struct {
+		user, pass string
+		want       string
+	}{
+		{"", "", ""},
+		{"app", "", "app"},
+		{"", "secret", ":secret"},
+		{"app", "p@ss", "app:p%40ss"},
+		{"app", "p:ss", "app:p%3Ass"},
+		{"app", "p/ss", "app:p%2Fss"},
+		{"app", "p%ss", "app:p%25ss"},
+		{"a@b", "p@:/%", "a%40b:p%40%3A%2F%25"},
+	}
+	for _, tt := range tests {
+		got := escapeUserinfo(tt.user, tt.pass)
+		if got != tt.want {
+			t.Errorf("escapeUserinfo(%q, %q) = %q, want %q", tt.user, tt.pass, got, tt.want)
+			continue
+		}
+		if got == "" {
+			continue
+		}
+		u, err := url.Parse("redis://" + got + "@localhost:6379")
+		if err != nil {
+			t.Errorf("url.Parse(%q) error = %v", got, err)
+			continue
+		}
+		pass, _ := u.User.Password()
+		if u.User.Username() != tt.user || pass != tt.pass {
+			t.Errorf("escapeUserinfo(%q, %q) parsed back as %q, %q", tt.user, tt.pass, u.User.Username(), pass)
+		}
+	}
+}
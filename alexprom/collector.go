@@ -0,0 +1,88 @@
+// Package alexprom exposes metrics about the configurations built by the alex package
+// as a Prometheus collector. It lives in its own package so that importing alex does not
+// pull in the Prometheus client.
+package alexprom
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/zeroxsolutions/alex"
+)
+
+var (
+	buildsDesc = prometheus.NewDesc(
+		"alex_config_builds_total",
+		"Number of configurations successfully built, by kind.",
+		[]string{"kind"}, nil,
+	)
+	lastBuildDesc = prometheus.NewDesc(
+		"alex_config_last_build_timestamp_seconds",
+		"Unix time of the last successful configuration build, by kind.",
+		[]string{"kind"}, nil,
+	)
+)
+
+// ConfigCollector is a prometheus.Collector counting the configurations built by the alex package
+// and recording when each kind was last built. It is fed by an alex build hook.
+type ConfigCollector struct {
+	mu        sync.Mutex           // mu guards builds and lastBuild
+	builds    map[string]float64   // builds counts the successful builds per kind
+	lastBuild map[string]time.Time // lastBuild is the time of the last successful build per kind
+}
+
+// NewConfigCollector creates a ConfigCollector and registers the alex build hook feeding it.
+// Only builds completed after the call are counted. The collector still has to be registered
+// with a Prometheus registry.
+//
+// Returns:
+//   - *ConfigCollector: A new collector exposing alex_config_builds_total and alex_config_last_build_timestamp_seconds
+//
+// Example:
+//
+//	prometheus.MustRegister(alexprom.NewConfigCollector())
+func NewConfigCollector() *ConfigCollector {
+	c := &ConfigCollector{
+		builds:    make(map[string]float64),
+		lastBuild: make(map[string]time.Time),
+	}
+	alex.AddBuildHook(c.observe)
+	return c
+}
+
+// observe records a successful build of config.
+//
+// Parameters:
+//   - config: The configuration that was built
+func (c *ConfigCollector) observe(config alex.Config) {
+	kind := config.Labels()["kind"]
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.builds[kind]++
+	c.lastBuild[kind] = time.Now()
+}
+
+// Describe sends the descriptors of the collected metrics to ch.
+// This method implements the prometheus.Collector interface.
+//
+// Parameters:
+//   - ch: The channel receiving the descriptors
+func (c *ConfigCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- buildsDesc
+	ch <- lastBuildDesc
+}
+
+// Collect sends the current metric values to ch.
+// This method implements the prometheus.Collector interface.
+//
+// Parameters:
+//   - ch: The channel receiving the metrics
+func (c *ConfigCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for kind, n := range c.builds {
+		ch <- prometheus.MustNewConstMetric(buildsDesc, prometheus.CounterValue, n, kind)
+		ch <- prometheus.MustNewConstMetric(lastBuildDesc, prometheus.GaugeValue, float64(c.lastBuild[kind].UnixNano())/1e9, kind)
+	}
+}
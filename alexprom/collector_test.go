@@ -0,0 +1,59 @@
+package alexprom
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/zeroxsolutions/alex"
+)
+
+// gatherBuilds returns the alex_config_builds_total value per kind gathered from reg.
+func gatherBuilds(t *testing.T, reg *prometheus.Registry) map[string]float64 {
+	t.Helper()
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather() error = %v", err)
+	}
+	builds := map[string]float64{}
+	for _, family := range families {
+		if family.GetName() != "alex_config_builds_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "kind" {
+					builds[label.GetValue()] = metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return builds
+}
+
+func TestConfigCollector(t *testing.T) {
+	reg := prometheus.NewPedanticRegistry()
+	collector := NewConfigCollector()
+	if err := reg.Register(collector); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if builds := gatherBuilds(t, reg); len(builds) != 0 {
+		t.Errorf("builds before any construction = %v, want none", builds)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := alex.NewRedisConfig(alex.NewRedisConfigOptions().SetAddr("localhost:6379")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := alex.NewFileBucketConfig(alex.NewFileBucketOption().SetBasePath(t.TempDir())); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := alex.NewRedisConfig(alex.NewRedisConfigOptions()); err == nil {
+		t.Fatal("NewRedisConfig() without an address error = nil")
+	}
+
+	builds := gatherBuilds(t, reg)
+	if builds["redis"] != 2 || builds["file_bucket"] != 1 || len(builds) != 2 {
+		t.Errorf("alex_config_builds_total = %v, want redis 2 and file_bucket 1", builds)
+	}
+}
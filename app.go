@@ -0,0 +1,189 @@
+package alex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/zeroxsolutions/strike/builderutil"
+)
+
+// NewAppConfig creates a new AppConfig from AppConfigOptions by applying the provided option functions.
+// It uses the builderutil package to build the configuration options in a functional options pattern,
+// then builds each configured backend with its own constructor.
+// Backends disabled via their SetEnabled(false) option skip validation, so their fields are not required.
+//
+// Parameters:
+//   - opts: Variable number of option functions that configure the AppConfigOptions
+//
+// Returns:
+//   - *AppConfig: A pointer to the final application configuration instance
+//   - error: An error if the configuration building process fails or any enabled backend fails validation
+//
+// Example:
+//
+//	builder := NewAppConfigOptions()
+//	config, err := NewAppConfig(builder.
+//	    SetRedis(NewRedisConfigOptions().SetAddr("localhost:6379")).
+//	    SetMinio(NewMinioOption().SetEnabled(false)))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewAppConfig(opts ...builderutil.Lister[AppConfigOptions]) (*AppConfig, error) {
+	options, err := builderutil.Build(opts...)
+	if err != nil {
+		return nil, err
+	}
+	config := &AppConfig{}
+	if len(options.Redis) > 0 {
+		if config.Redis, err = NewRedisConfig(options.Redis...); err != nil {
+			return nil, err
+		}
+	}
+	if len(options.Minio) > 0 {
+		if config.Minio, err = NewMinioConfig(options.Minio...); err != nil {
+			return nil, err
+		}
+	}
+	if len(options.FileBucket) > 0 {
+		if config.FileBucket, err = NewFileBucketConfig(options.FileBucket...); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// HealthCheck verifies that every configured and enabled backend is reachable.
+// Redis is checked by dialing its address over its Network (tcp or unix), Minio by checking that the bucket exists (when one is configured),
+// and the file bucket by checking that its base path is a directory.
+// Backends that are not configured or are disabled are skipped.
+//
+// Parameters:
+//   - ctx: The context used for the checks
+//
+// Returns:
+//   - error: The joined errors of every failing backend, or nil if all are healthy
+func (a *AppConfig) HealthCheck(ctx context.Context) error {
+	var errs []error
+	if a.Redis != nil && a.Redis.Enabled {
+		conn, err := (&net.Dialer{}).DialContext(ctx, a.Redis.Network, a.Redis.Addr)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("redis health check failed: %w", err))
+		} else {
+			conn.Close()
+		}
+	}
+	if a.Minio != nil && a.Minio.Enabled {
+		if err := checkMinioBucket(ctx, a.Minio); err != nil {
+			errs = append(errs, fmt.Errorf("minio health check failed: %w", err))
+		}
+	}
+	if a.FileBucket != nil && a.FileBucket.Enabled {
+		info, err := os.Stat(a.FileBucket.BasePath)
+		if err == nil && !info.IsDir() {
+			err = fmt.Errorf("%s is not a directory", a.FileBucket.BasePath)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("file bucket health check failed: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateOnly re-runs Validate on every configured and enabled backend without opening any connection,
+// so that configuration errors can be reported before a deploy, separately from the connectivity
+// errors reported by HealthCheck.
+// Backends that are not configured or are disabled are skipped.
+//
+// Returns:
+//   - error: The joined validation errors of every invalid backend, or nil if all are valid
+//
+// Example:
+//
+//	if err := app.ValidateOnly(); err != nil {
+//	    log.Fatalf("invalid configuration: %v", err)
+//	}
+func (a *AppConfig) ValidateOnly() error {
+	var errs []error
+	if a.Redis != nil && a.Redis.Enabled {
+		if err := a.Redis.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("redis: %w", err))
+		}
+	}
+	if a.Minio != nil && a.Minio.Enabled {
+		if err := a.Minio.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("minio: %w", err))
+		}
+	}
+	if a.FileBucket != nil && a.FileBucket.Enabled {
+		if err := a.FileBucket.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("file bucket: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// NewRedisClient creates a go-redis client from the Redis backend configuration and tracks it,
+// so that it is closed by Close.
+//
+// Returns:
+//   - *redis.Client: A client configured with RedisConfig.RedisOptions
+//   - error: An error if the Redis backend is not configured or is disabled
+//
+// Example:
+//
+//	client, err := app.NewRedisClient()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer app.Close()
+func (a *AppConfig) NewRedisClient() (*redis.Client, error) {
+	if a.Redis == nil || !a.Redis.Enabled {
+		return nil, errors.New("redis backend is not configured or is disabled")
+	}
+	client := redis.NewClient(a.Redis.RedisOptions())
+	a.AddCloser(client)
+	return client, nil
+}
+
+// AddCloser tracks a client constructed from the configuration, so that it is closed by Close.
+// Minio clients hold no resources that need closing and do not have to be tracked.
+//
+// Parameters:
+//   - c: The client to close on shutdown
+func (a *AppConfig) AddCloser(c io.Closer) {
+	a.closers.add(c)
+}
+
+// Close closes every tracked client, in reverse order of registration, and stops tracking them.
+// Every client is closed even when some fail.
+//
+// Returns:
+//   - error: The joined close errors, or nil if every client closed cleanly
+func (a *AppConfig) Close() error {
+	return a.closers.closeAll()
+}
+
+// checkMinioBucket reports an error when the configured bucket cannot be reached or does not exist.
+// Without a bucket name (see SetBucketOptional), there is no bucket to check and only the client is built.
+func checkMinioBucket(ctx context.Context, config *MinioConfig) error {
+	client, err := config.NewClient()
+	if err != nil {
+		return err
+	}
+	if config.BucketName == "" {
+		return nil
+	}
+	exists, err := client.BucketExists(ctx, config.BucketName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("bucket %q does not exist", config.BucketName)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package alex
+
+import (
+	"slices"
+
+	"github.com/zeroxsolutions/strike/builderutil"
+)
+
+// AppConfigOptions holds the configuration options for the backends used by an application.
+// Each backend is described by the option listers of its own builder; a backend without
+// listers is not configured.
+// This struct is used as input for building the final AppConfig.
+type AppConfigOptions struct {
+	Redis      []builderutil.Lister[RedisConfigOptions] // Redis contains the option listers for the Redis backend.
+	Minio      []builderutil.Lister[MinioOption]        // Minio contains the option listers for the Minio backend.
+	FileBucket []builderutil.Lister[FileBucketOption]   // FileBucket contains the option listers for the file bucket backend.
+}
+
+// AppConfigOptionsBuilder provides a builder pattern for constructing AppConfigOptions.
+// It accumulates option functions that can be applied to configure an AppConfigOptions instance.
+// This builder implements the builderutil.Lister interface to work with the functional options pattern.
+type AppConfigOptionsBuilder struct {
+	Opts []func(*AppConfigOptions) error // Opts contains the list of option functions to be applied
+}
+
+// SetRedis configures the Redis backend of the application.
+// It appends an option function that sets the Redis field of AppConfigOptions.
+//
+// Parameters:
+//   - opts: The option listers configuring the Redis backend (typically a RedisConfigOptionsBuilder)
+//
+// Returns:
+//   - *AppConfigOptionsBuilder: The builder instance for method chaining
+func (b *AppConfigOptionsBuilder) SetRedis(opts ...builderutil.Lister[RedisConfigOptions]) *AppConfigOptionsBuilder {
+	b.Opts = append(b.Opts, func(o *AppConfigOptions) error {
+		o.Redis = opts
+		return nil
+	})
+	return b
+}
+
+// SetMinio configures the Minio backend of the application.
+// It appends an option function that sets the Minio field of AppConfigOptions.
+//
+// Parameters:
+//   - opts: The option listers configuring the Minio backend (typically a MinioOptionBuilder)
+//
+// Returns:
+//   - *AppConfigOptionsBuilder: The builder instance for method chaining
+func (b *AppConfigOptionsBuilder) SetMinio(opts ...builderutil.Lister[MinioOption]) *AppConfigOptionsBuilder {
+	b.Opts = append(b.Opts, func(o *AppConfigOptions) error {
+		o.Minio = opts
+		return nil
+	})
+	return b
+}
+
+// SetFileBucket configures the file bucket backend of the application.
+// It appends an option function that sets the FileBucket field of AppConfigOptions.
+//
+// Parameters:
+//   - opts: The option listers configuring the file bucket backend (typically a FileBucketOptionBuilder)
+//
+// Returns:
+//   - *AppConfigOptionsBuilder: The builder instance for method chaining
+func (b *AppConfigOptionsBuilder) SetFileBucket(opts ...builderutil.Lister[FileBucketOption]) *AppConfigOptionsBuilder {
+	b.Opts = append(b.Opts, func(o *AppConfigOptions) error {
+		o.FileBucket = opts
+		return nil
+	})
+	return b
+}
+
+// List returns the slice of option functions accumulated by the builder.
+// This method implements the builderutil.Lister interface, allowing the builder
+// to be used with the builderutil.Build function.
+// The functions are returned in the order the setters were called and builderutil.Build applies them
+// in that order, so when several setters touch the same field the last call wins. The returned slice
+// is a copy, so callers cannot reorder or drop the queued options.
+//
+// Returns:
+//   - []func(*AppConfigOptions) error: A slice of option functions that can be applied to configure AppConfigOptions
+func (b *AppConfigOptionsBuilder) List() []func(*AppConfigOptions) error {
+	return slices.Clone(b.Opts)
+}
+
+// NewAppConfigOptions creates and returns a new instance of AppConfigOptionsBuilder.
+// This function provides a convenient way to initialize the builder for creating application configuration options.
+//
+// Returns:
+//   - *AppConfigOptionsBuilder: A new instance of AppConfigOptionsBuilder ready to be configured
+//
+// Example:
+//
+//	builder := NewAppConfigOptions()
+//	config, err := NewAppConfig(builder.
+//	    SetRedis(NewRedisConfigOptions().SetAddr("localhost:6379")).
+//	    SetMinio(NewMinioOption().SetEnabled(false)))
+func NewAppConfigOptions() *AppConfigOptionsBuilder {
+	return &AppConfigOptionsBuilder{}
+}
+
+// AppConfig represents the final configuration of every backend used by an application.
+// This struct is created from AppConfigOptions after each backend has been built and validated.
+// A backend that was not configured is nil; a disabled backend has its Enabled field set to false.
+type AppConfig struct {
+	Redis      *RedisConfig      // Redis is the Redis backend configuration.
+	Minio      *MinioConfig      // Minio is the Minio backend configuration.
+	FileBucket *FileBucketConfig // FileBucket is the file bucket backend configuration.
+	closers    closerRegistry    // closers tracks the clients to close with Close.
+}
@@ -0,0 +1,132 @@
+package alex
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// NewAppConfigFromEnv creates a new AppConfig from environment variables, as a single bootstrap call.
+// Each backend is read from the variables under its own prefix, whose remaining name is matched
+// case-insensitively against the keys listed below; a backend without any variable is not configured.
+//
+//   - <PREFIX>_REDIS_*: the keys of RedisConfig.ToMap (e.g., <PREFIX>_REDIS_ADDR, <PREFIX>_REDIS_DB)
+//   - <PREFIX>_MINIO_*: ENDPOINT, ACCESS_KEY, SECRET_KEY, USE_SSL, BUCKET_NAME, REGION, KEY_PREFIX, PATH_STYLE
+//   - <PREFIX>_FILEBUCKET_*: BASE_PATH, TEMP_SUFFIX, MAX_TOTAL_SIZE, MAX_FILE_SIZE, CREATE_IF_MISSING
+//
+// Unlike NewAppConfig, every backend is built even when another one fails, so that all the
+// misconfigurations are reported at once.
+//
+// Parameters:
+//   - prefix: The prefix of the variables, without the trailing underscore (e.g., "BILLING")
+//
+// Returns:
+//   - *AppConfig: A pointer to the final application configuration instance
+//   - error: The joined errors of every backend that cannot be parsed or fails validation, each prefixed with the backend name
+//
+// Example:
+//
+//	// BILLING_REDIS_ADDR=localhost:6379 BILLING_FILEBUCKET_BASE_PATH=/var/data
+//	config, err := NewAppConfigFromEnv("BILLING")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewAppConfigFromEnv(prefix string) (*AppConfig, error) {
+	config := &AppConfig{}
+	var errs []error
+	if env := envWithPrefix(prefix + "_REDIS_"); len(env) > 0 {
+		redis, err := NewRedisConfigFromMap(env)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("redis: %w", err))
+		}
+		config.Redis = redis
+	}
+	if env := envWithPrefix(prefix + "_MINIO_"); len(env) > 0 {
+		minio, err := newMinioConfigFromEnv(env)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("minio: %w", err))
+		}
+		config.Minio = minio
+	}
+	if env := envWithPrefix(prefix + "_FILEBUCKET_"); len(env) > 0 {
+		fileBucket, err := newFileBucketConfigFromEnv(env)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("file bucket: %w", err))
+		}
+		config.FileBucket = fileBucket
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// newMinioConfigFromEnv builds a MinioConfig from the variables collected by envWithPrefix.
+func newMinioConfigFromEnv(env map[string]string) (*MinioConfig, error) {
+	builder := NewMinioOption().
+		SetEndpoint(env["endpoint"]).
+		SetAccessKey(env["access_key"]).
+		SetSecretKey(env["secret_key"]).
+		SetBucketName(env["bucket_name"]).
+		SetRegion(env["region"]).
+		SetKeyPrefix(env["key_prefix"])
+	if v, ok := env["use_ssl"]; ok {
+		useSSL, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("minio use ssl %q is invalid: %w", v, err)
+		}
+		builder.SetUseSSL(useSSL)
+	}
+	if v, ok := env["path_style"]; ok {
+		pathStyle, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("minio path style %q is invalid: %w", v, err)
+		}
+		builder.SetPathStyle(pathStyle)
+	}
+	return NewMinioConfig(builder)
+}
+
+// newFileBucketConfigFromEnv builds a FileBucketConfig from the variables collected by envWithPrefix.
+func newFileBucketConfigFromEnv(env map[string]string) (*FileBucketConfig, error) {
+	builder := NewFileBucketOption().
+		SetBasePath(env["base_path"]).
+		SetTempSuffix(env["temp_suffix"])
+	if v, ok := env["max_total_size"]; ok {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("file bucket max total size %q is invalid: %w", v, err)
+		}
+		builder.SetMaxTotalSize(n)
+	}
+	if v, ok := env["max_file_size"]; ok {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("file bucket max file size %q is invalid: %w", v, err)
+		}
+		builder.SetMaxFileSize(n)
+	}
+	if v, ok := env["create_if_missing"]; ok {
+		create, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("file bucket create if missing %q is invalid: %w", v, err)
+		}
+		builder.SetCreateIfMissing(create)
+	}
+	return NewFileBucketConfig(builder)
+}
+
+// envWithPrefix returns the environment variables whose name starts with prefix (compared case-insensitively),
+// keyed by the rest of their name in lower case (e.g., "APP_REDIS_ADDR" yields "addr" for the prefix "APP_REDIS_").
+func envWithPrefix(prefix string) map[string]string {
+	env := make(map[string]string)
+	for _, kv := range os.Environ() {
+		name, value, _ := strings.Cut(kv, "=")
+		if len(name) > len(prefix) && strings.EqualFold(name[:len(prefix)], prefix) {
+			env[strings.ToLower(name[len(prefix):])] = value
+		}
+	}
+	return env
+}
@@ -0,0 +1,68 @@
+package alex
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewAppConfigFromEnv(t *testing.T) {
+	dir := t.TempDir()
+	for name, value := range map[string]string{
+		"ALEXTEST_REDIS_ADDR":                   "cache:6380",
+		"ALEXTEST_REDIS_DB":                     "2",
+		"ALEXTEST_MINIO_ENDPOINT":               "minio.local:9000",
+		"ALEXTEST_MINIO_ACCESS_KEY":             "minioadmin",
+		"ALEXTEST_MINIO_SECRET_KEY":             "minioadmin",
+		"ALEXTEST_MINIO_BUCKET_NAME":            "uploads",
+		"ALEXTEST_MINIO_PATH_STYLE":             "true",
+		"alextest_filebucket_base_path":         dir,
+		"ALEXTEST_FILEBUCKET_MAX_FILE_SIZE":     "1024",
+		"ALEXTEST_FILEBUCKET_CREATE_IF_MISSING": "false",
+	} {
+		t.Setenv(name, value)
+	}
+	config, err := NewAppConfigFromEnv("ALEXTEST")
+	if err != nil {
+		t.Fatalf("NewAppConfigFromEnv() error = %v", err)
+	}
+	if config.Redis == nil || config.Redis.Addr != "cache:6380" || config.Redis.DB != 2 {
+		t.Errorf("Redis = %+v, want cache:6380 database 2", config.Redis)
+	}
+	if config.Minio == nil || config.Minio.Endpoint != "minio.local:9000" || config.Minio.BucketName != "uploads" || !config.Minio.PathStyle {
+		t.Errorf("Minio = %+v, want the minio.local:9000 uploads bucket in path style", config.Minio)
+	}
+	if config.FileBucket == nil || config.FileBucket.BasePath != dir || config.FileBucket.MaxFileSize != 1024 {
+		t.Errorf("FileBucket = %+v, want %s limited to 1024 bytes", config.FileBucket, dir)
+	}
+}
+
+func TestNewAppConfigFromEnvPartial(t *testing.T) {
+	t.Setenv("ALEXTEST_REDIS_ADDR", "cache:6379")
+	config, err := NewAppConfigFromEnv("ALEXTEST")
+	if err != nil {
+		t.Fatalf("NewAppConfigFromEnv() error = %v", err)
+	}
+	if config.Redis == nil || config.Minio != nil || config.FileBucket != nil {
+		t.Errorf("config = %+v, want only Redis to be configured", config)
+	}
+}
+
+func TestNewAppConfigFromEnvErrors(t *testing.T) {
+	t.Setenv("ALEXTEST_REDIS_DB", "1")
+	t.Setenv("ALEXTEST_MINIO_ENDPOINT", "minio.local:9000")
+	t.Setenv("ALEXTEST_FILEBUCKET_MAX_FILE_SIZE", "large")
+	config, err := NewAppConfigFromEnv("ALEXTEST")
+	if err == nil {
+		t.Fatalf("NewAppConfigFromEnv() = %+v, want an error", config)
+	}
+	for _, want := range []string{"redis: ", "minio: ", "file bucket: "} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error = %q, want it to report %q", err, want)
+		}
+	}
+	var ve *ValidationError
+	if !errors.As(err, &ve) || ve.Field != "Addr" {
+		t.Errorf("error = %v, want the first validation error to be the missing Redis address", err)
+	}
+}
@@ -0,0 +1,206 @@
+package alex
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNewAppConfigSkipsDisabledBackends(t *testing.T) {
+	dir := t.TempDir()
+	app, err := NewAppConfig(NewAppConfigOptions().
+		SetRedis(NewRedisConfigOptions().SetAddr("localhost:6379")).
+		SetMinio(NewMinioOption().SetEnabled(false)).
+		SetFileBucket(NewFileBucketOption().SetBasePath(dir)))
+	if err != nil {
+		t.Fatalf("NewAppConfig() error = %v, want a disabled Minio backend to need no fields", err)
+	}
+	if app.Minio == nil || app.Minio.Enabled {
+		t.Errorf("Minio = %+v, want a disabled config", app.Minio)
+	}
+	if !app.Redis.Enabled || !app.FileBucket.Enabled {
+		t.Error("Redis and file bucket backends are not enabled by default")
+	}
+
+	_, err = NewAppConfig(NewAppConfigOptions().SetMinio(NewMinioOption().SetEndpoint("localhost:9000")))
+	requireValidationError(t, err, "AccessKey", RuleRequired)
+}
+
+func TestDisabledBackendsNeedNoFields(t *testing.T) {
+	redis, err := NewRedisConfig(NewRedisConfigOptions().SetEnabled(false))
+	if err != nil || redis.Enabled {
+		t.Errorf("NewRedisConfig(disabled) = %+v, %v, want a disabled config", redis, err)
+	}
+	minio, err := NewMinioConfig(NewMinioOption().SetEnabled(false))
+	if err != nil || minio.Enabled {
+		t.Errorf("NewMinioConfig(disabled) = %+v, %v, want a disabled config", minio, err)
+	}
+	bucket, err := NewFileBucketConfig(NewFileBucketOption().SetEnabled(false))
+	if err != nil || bucket.Enabled {
+		t.Errorf("NewFileBucketConfig(disabled) = %+v, %v, want a disabled config", bucket, err)
+	}
+}
+
+func TestAppConfigHealthCheckSkipsDisabledBackends(t *testing.T) {
+	app, err := NewAppConfig(NewAppConfigOptions().
+		SetRedis(NewRedisConfigOptions().SetEnabled(false)).
+		SetMinio(NewMinioOption().SetEnabled(false)).
+		SetFileBucket(NewFileBucketOption().SetBasePath(t.TempDir())))
+	if err != nil {
+		t.Fatalf("NewAppConfig() error = %v", err)
+	}
+	if err := app.HealthCheck(context.Background()); err != nil {
+		t.Errorf("HealthCheck() error = %v, want disabled backends to be skipped", err)
+	}
+
+	app.FileBucket.BasePath = filepath.Join(t.TempDir(), "missing")
+	if err := app.HealthCheck(context.Background()); err == nil {
+		t.Error("HealthCheck() error = nil, want an error for a missing file bucket directory")
+	}
+}
+
+func TestAppConfigHealthCheckUnixRedis(t *testing.T) {
+	// Unix socket paths are limited to about 100 bytes, too short for t.TempDir on some systems.
+	dir, err := os.MkdirTemp("", "alex")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	socket := filepath.Join(dir, "redis.sock")
+	app, err := NewAppConfig(NewAppConfigOptions().SetRedis(NewRedisConfigOptions().SetNetwork("unix").SetAddr(socket)))
+	if err != nil {
+		t.Fatalf("NewAppConfig() error = %v", err)
+	}
+	if err := app.HealthCheck(context.Background()); err == nil {
+		t.Error("HealthCheck() error = nil, want an error before the socket is listening")
+	}
+
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	if err := app.HealthCheck(context.Background()); err != nil {
+		t.Errorf("HealthCheck() error = %v, want the unix socket to be dialed", err)
+	}
+}
+
+func TestAppConfigHealthCheckMinioBucket(t *testing.T) {
+	minio, requests := newTestS3Server(t, func(w http.ResponseWriter, r *http.Request) {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket")
+	})
+	app := &AppConfig{Minio: minio}
+	if err := app.HealthCheck(context.Background()); err == nil {
+		t.Error("HealthCheck() error = nil, want an error for a missing bucket")
+	}
+	if len(*requests) == 0 {
+		t.Fatal("HealthCheck() sent no request, want the bucket to be checked")
+	}
+
+	*requests = nil
+	minio.BucketName = ""
+	minio.BucketOptional = true
+	if err := app.HealthCheck(context.Background()); err != nil {
+		t.Errorf("HealthCheck() error = %v, want no bucket check without a bucket name", err)
+	}
+	if len(*requests) != 0 {
+		t.Errorf("HealthCheck() sent %d requests, want none without a bucket name", len(*requests))
+	}
+}
+
+func TestAppConfigValidateOnly(t *testing.T) {
+	// Every backend is unreachable: nothing listens on port 1 and the file bucket directory is removed.
+	redis, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("127.0.0.1:1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	minio, err := NewMinioConfig(newTestMinioOptions().SetEndpoint("127.0.0.1:1").SetRegion("us-east-1").SetMaxRetries(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bucket := newTestFileBucket(t, nil)
+	bucket.BasePath = filepath.Join(bucket.BasePath, "missing")
+	app := &AppConfig{Redis: redis, Minio: minio, FileBucket: bucket}
+	if err := app.ValidateOnly(); err != nil {
+		t.Errorf("ValidateOnly() error = %v, want unreachable but valid backends to pass", err)
+	}
+	if err := app.HealthCheck(context.Background()); err == nil {
+		t.Error("HealthCheck() error = nil, want connectivity errors")
+	}
+
+	redis.DB = -1
+	bucket.TempSuffix = ""
+	err = app.ValidateOnly()
+	if err == nil {
+		t.Fatal("ValidateOnly() error = nil, want the invalid fields to be reported")
+	}
+	for _, want := range []string{"redis: ", "file bucket: "} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("ValidateOnly() error = %q, want it to report %q", err, want)
+		}
+	}
+	if strings.Contains(err.Error(), "minio: ") {
+		t.Errorf("ValidateOnly() error = %q, want the valid Minio backend not to be reported", err)
+	}
+	requireValidationError(t, err, "DB", RuleMin)
+
+	redis.Enabled = false
+	bucket.Enabled = false
+	if err := app.ValidateOnly(); err != nil {
+		t.Errorf("ValidateOnly() error = %v, want disabled backends to be skipped", err)
+	}
+}
+
+// closeFunc is an io.Closer calling a function.
+type closeFunc func() error
+
+func (f closeFunc) Close() error { return f() }
+
+func TestAppConfigClose(t *testing.T) {
+	app := &AppConfig{}
+	var order []string
+	errFirst, errThird := errors.New("first failed"), errors.New("third failed")
+	app.AddCloser(closeFunc(func() error { order = append(order, "first"); return errFirst }))
+	app.AddCloser(closeFunc(func() error { order = append(order, "second"); return nil }))
+	app.AddCloser(closeFunc(func() error { order = append(order, "third"); return errThird }))
+
+	err := app.Close()
+	if want := []string{"third", "second", "first"}; !slices.Equal(order, want) {
+		t.Errorf("closed %v, want every closer in reverse order %v", order, want)
+	}
+	if !errors.Is(err, errFirst) || !errors.Is(err, errThird) {
+		t.Errorf("Close() error = %v, want both close errors joined", err)
+	}
+
+	order = nil
+	if err := app.Close(); err != nil || len(order) != 0 {
+		t.Errorf("second Close() = %v closing %v, want nothing left to close", err, order)
+	}
+}
+
+func TestAppConfigNewRedisClientTracked(t *testing.T) {
+	app, err := NewAppConfig(NewAppConfigOptions().SetRedis(NewRedisConfigOptions().SetAddr("localhost:6379")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := app.NewRedisClient()
+	if err != nil {
+		t.Fatalf("NewRedisClient() error = %v", err)
+	}
+	if err := app.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if err := client.Close(); err == nil {
+		t.Error("client.Close() error = nil after AppConfig.Close, want the client already closed")
+	}
+
+	if _, err := (&AppConfig{}).NewRedisClient(); err == nil {
+		t.Error("NewRedisClient() without a Redis backend error = nil")
+	}
+}
@@ -0,0 +1,44 @@
+package alex
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"sync"
+)
+
+// closerRegistry tracks the clients constructed from a configuration so that they can be closed together.
+// Its zero value is ready to use and it is safe for concurrent use.
+type closerRegistry struct {
+	mu      sync.Mutex  // mu guards closers
+	closers []io.Closer // closers are the tracked clients, in registration order
+}
+
+// add starts tracking c.
+//
+// Parameters:
+//   - c: The client to close with closeAll
+func (r *closerRegistry) add(c io.Closer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closers = append(r.closers, c)
+}
+
+// closeAll closes every tracked client in reverse registration order and stops tracking them.
+// Every client is closed even when some fail.
+//
+// Returns:
+//   - error: The joined close errors, or nil if every client closed cleanly
+func (r *closerRegistry) closeAll() error {
+	r.mu.Lock()
+	closers := r.closers
+	r.closers = nil
+	r.mu.Unlock()
+	var errs []error
+	for _, c := range slices.Backward(closers) {
+		if err := c.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
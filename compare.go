@@ -0,0 +1,39 @@
+package alex
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// CmpOptions returns go-cmp options that make cmp.Diff and cmp.Equal work out of the box on the
+// configuration types of this package, including AppConfig and Registry. They ignore unexported fields
+// (tracked clients, locks, and internal bookkeeping), HTTP transports, DNS resolvers, and trace writers,
+// and compare TLS configurations and client certificate callbacks opaquely, as equal when both are set
+// or both are nil, since they hold certificates, keys, and callbacks that cannot be compared meaningfully.
+//
+// Returns:
+//   - cmp.Options: The options to pass to cmp.Diff or cmp.Equal
+//
+// Example:
+//
+//	if diff := cmp.Diff(want, got, CmpOptions()); diff != "" {
+//	    t.Errorf("config mismatch (-want +got):\n%s", diff)
+//	}
+func CmpOptions() cmp.Options {
+	return cmp.Options{
+		cmpopts.IgnoreUnexported(AppConfig{}, RedisConfig{}, RedisConfigOptionsBuilder{}, Registry{}),
+		cmpopts.IgnoreTypes(&http.Transport{}, &net.Resolver{}),
+		cmpopts.IgnoreInterfaces(struct{ io.Writer }{}),
+		cmp.Comparer(func(a, b *tls.Config) bool {
+			return (a == nil) == (b == nil)
+		}),
+		cmp.Comparer(func(a, b func(*tls.CertificateRequestInfo) (*tls.Certificate, error)) bool {
+			return (a == nil) == (b == nil)
+		}),
+	}
+}
@@ -0,0 +1,76 @@
+package alex
+
+import (
+	"bytes"
+	"crypto/tls"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCmpOptionsRedisConfig(t *testing.T) {
+	build := func(db int) *RedisConfig {
+		t.Helper()
+		config, err := NewRedisConfig(NewRedisConfigOptions().
+			SetAddr("localhost:6379").
+			SetDB(db).
+			SetTLSConfigFunc(func() (*tls.Config, error) { return &tls.Config{MinVersion: tls.VersionTLS12}, nil }).
+			SetClientCertificateFunc(func(*tls.CertificateRequestInfo) (*tls.Certificate, error) { return nil, nil }))
+		if err != nil {
+			t.Fatalf("NewRedisConfig() error = %v", err)
+		}
+		return config
+	}
+	if diff := cmp.Diff(build(1), build(1), CmpOptions()); diff != "" {
+		t.Errorf("cmp.Diff() on equivalent configs = %s, want no difference", diff)
+	}
+	diff := cmp.Diff(build(1), build(2), CmpOptions())
+	if !strings.Contains(diff, "DB") {
+		t.Errorf("cmp.Diff() = %q, want a DB difference", diff)
+	}
+}
+
+func TestCmpOptionsMinioConfig(t *testing.T) {
+	build := func(resolver *net.Resolver, trace io.Writer) *MinioConfig {
+		t.Helper()
+		config, err := NewMinioConfig(newTestMinioOptions().SetResolver(resolver).SetTraceWriter(trace))
+		if err != nil {
+			t.Fatalf("NewMinioConfig() error = %v", err)
+		}
+		return config
+	}
+	a := build(&net.Resolver{PreferGo: true}, &bytes.Buffer{})
+	b := build(&net.Resolver{}, &strings.Builder{})
+	if diff := cmp.Diff(a, b, CmpOptions()); diff != "" {
+		t.Errorf("cmp.Diff() = %s, want resolvers and trace writers ignored", diff)
+	}
+}
+
+func TestCmpOptionsAppConfigAndRegistry(t *testing.T) {
+	build := func() *AppConfig {
+		t.Helper()
+		app, err := NewAppConfig(NewAppConfigOptions().SetRedis(NewRedisConfigOptions().SetAddr("localhost:6379")))
+		if err != nil {
+			t.Fatalf("NewAppConfig() error = %v", err)
+		}
+		return app
+	}
+	a, b := build(), build()
+	a.AddCloser(io.NopCloser(nil))
+	if diff := cmp.Diff(a, b, CmpOptions()); diff != "" {
+		t.Errorf("cmp.Diff() on AppConfig = %s, want tracked clients ignored", diff)
+	}
+
+	ra, rb := NewRegistry(), NewRegistry()
+	for _, r := range []*Registry{ra, rb} {
+		if err := r.Register("cache", a.Redis); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if diff := cmp.Diff(ra, rb, CmpOptions()); diff != "" {
+		t.Errorf("cmp.Diff() on Registry = %s, want no difference", diff)
+	}
+}
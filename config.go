@@ -0,0 +1,100 @@
+package alex
+
+import (
+	"log/slog"
+	"net/url"
+	"strings"
+)
+
+// Config is implemented by every final configuration type of the package,
+// so that configurations of mixed kinds can be stored in one collection
+// and validated and labeled uniformly.
+type Config interface {
+	// Validate checks the configuration without performing any I/O.
+	Validate() error
+	// Labels returns identifying, non-secret key/value pairs describing the configuration.
+	Labels() map[string]string
+}
+
+var (
+	_ Config = (*RedisConfig)(nil)
+	_ Config = (*RedisSentinelConfig)(nil)
+	_ Config = (*MinioConfig)(nil)
+	_ Config = (*FileBucketConfig)(nil)
+	_ Config = (*SpannerConfig)(nil)
+	_ Config = (*RabbitMQConfig)(nil)
+	_ Config = (*MemcachedConfig)(nil)
+	_ Config = (*WebhookConfig)(nil)
+	_ Config = (*OpenSearchConfig)(nil)
+)
+
+var (
+	_ slog.LogValuer = (*RedisConfig)(nil)
+	_ slog.LogValuer = (*RedisSentinelConfig)(nil)
+	_ slog.LogValuer = (*MinioConfig)(nil)
+	_ slog.LogValuer = (*FileBucketConfig)(nil)
+	_ slog.LogValuer = (*SpannerConfig)(nil)
+	_ slog.LogValuer = (*RabbitMQConfig)(nil)
+	_ slog.LogValuer = (*MemcachedConfig)(nil)
+	_ slog.LogValuer = (*WebhookConfig)(nil)
+	_ slog.LogValuer = (*OpenSearchConfig)(nil)
+)
+
+// secretAttr returns a log attribute holding a secret value, redacted as in RedisConfigTextDiff
+// so that an unset secret stays visible as empty.
+//
+// Parameters:
+//   - key: The attribute key
+//   - v: The secret value
+//
+// Returns:
+//   - slog.Attr: An attribute whose value is "<redacted>" if v is set, or an empty string otherwise
+func secretAttr(key, v string) slog.Attr {
+	return slog.String(key, redactDiffValue(v))
+}
+
+// redactURL returns the URL with the password of its userinfo replaced by "xxxxx".
+// A URL that cannot be parsed is redacted entirely, since it may still hold credentials.
+//
+// Parameters:
+//   - rawURL: The URL to redact
+//
+// Returns:
+//   - string: The redacted URL
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return redactDiffValue(rawURL)
+	}
+	return u.Redacted()
+}
+
+// trimSpace removes the leading and trailing white space of each given string in place,
+// for the constructors of builders configured with SetTrimSpace(true).
+//
+// Parameters:
+//   - fields: Pointers to the string fields to trim
+func trimSpace(fields ...*string) {
+	for _, field := range fields {
+		*field = strings.TrimSpace(*field)
+	}
+}
+
+// trimSpaceAll returns a copy of values with the leading and trailing white space of each element removed.
+// A copy is returned because the slice may still be shared with the caller of a setter.
+//
+// Parameters:
+//   - values: The strings to trim
+//
+// Returns:
+//   - []string: The trimmed strings, or nil if values is nil
+func trimSpaceAll(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	trimmed := make([]string, len(values))
+	for i, v := range values {
+		trimmed[i] = strings.TrimSpace(v)
+	}
+	return trimmed
+}
@@ -0,0 +1,69 @@
+package alex
+
+import (
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// RedisDefaultPort is the Redis port used when a connection URL does not specify one.
+const RedisDefaultPort = 6379
+
+// RedisDefaults returns RedisConfigOptions pre-filled with the effective defaults of a Redis configuration:
+// the tcp network, database 0, and a read timeout of TimeoutDefault seconds, which NewRedisConfig also
+// stores when they are left unset. Every other field defaults to its zero value
+// (no credentials, TLS, pool tuning, or retries). Addr has no default and must be set.
+// The result can seed a builder with From.
+//
+// Returns:
+//   - RedisConfigOptions: The default Redis options
+//
+// Example:
+//
+//	config, err := NewRedisConfig(NewRedisConfigOptions().From(RedisDefaults()).SetAddr("localhost:6379"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func RedisDefaults() RedisConfigOptions {
+	return RedisConfigOptions{
+		Network:     "tcp",
+		DB:          0,
+		ReadTimeout: TimeoutDefault * time.Second,
+	}
+}
+
+// MinioDefaults returns a MinioOption pre-filled with the effective defaults of a Minio configuration:
+// a retry policy of minio.MaxRetry attempts, which NewMinioConfig also stores when neither
+// RetryPolicy.MaxAttempts nor MaxRetries is set. Every other field defaults to its zero value: SSL disabled,
+// no region, virtual-hosted bucket lookup, and the MinioMinAccessKeyLength/MinioMinSecretKeyLength key
+// length checks. Endpoint, AccessKey, SecretKey, and BucketName have no default and must be set.
+// The result can seed a builder with From.
+//
+// Returns:
+//   - MinioOption: The default Minio options
+//
+// Example:
+//
+//	builder := NewMinioOption().From(MinioDefaults()).SetEndpoint("minio.example.com")
+func MinioDefaults() MinioOption {
+	return MinioOption{
+		RetryPolicy: RetryPolicy{MaxAttempts: minio.MaxRetry},
+	}
+}
+
+// FileBucketDefaults returns a FileBucketOption pre-filled with the effective defaults of a file bucket
+// configuration: temporary files suffixed with DefaultTempSuffix and no size quota.
+// BasePath has no default and must be set.
+// The result can seed a builder with From.
+//
+// Returns:
+//   - FileBucketOption: The default file bucket options
+//
+// Example:
+//
+//	builder := NewFileBucketOption().From(FileBucketDefaults()).SetBasePath("/var/data")
+func FileBucketDefaults() FileBucketOption {
+	return FileBucketOption{
+		TempSuffix: DefaultTempSuffix,
+	}
+}
@@ -0,0 +1,104 @@
+package alex
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDefaultsMatchConstructors(t *testing.T) {
+	t.Run("redis", func(t *testing.T) {
+		defaults := RedisDefaults()
+		plain, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("localhost:6379"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		seeded, err := NewRedisConfig(NewRedisConfigOptions().From(defaults).SetAddr("localhost:6379"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(plain, seeded, CmpOptions()); diff != "" {
+			t.Errorf("config seeded with RedisDefaults differs (-plain +seeded):\n%s", diff)
+		}
+		if plain.Network != defaults.Network || plain.DB != defaults.DB || plain.ReadTimeout != defaults.ReadTimeout {
+			t.Errorf("constructor defaults = %s/%d/%v, want %s/%d/%v", plain.Network, plain.DB, plain.ReadTimeout, defaults.Network, defaults.DB, defaults.ReadTimeout)
+		}
+	})
+	t.Run("minio", func(t *testing.T) {
+		defaults := MinioDefaults()
+		plain, err := NewMinioConfig(newTestMinioOptions())
+		if err != nil {
+			t.Fatal(err)
+		}
+		seeded, err := NewMinioConfig(NewMinioOption().From(defaults).
+			SetEndpoint("localhost:9000").SetAccessKey("minioadmin").SetSecretKey("minioadmin").SetBucketName("bucket"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(plain, seeded, CmpOptions()); diff != "" {
+			t.Errorf("config seeded with MinioDefaults differs (-plain +seeded):\n%s", diff)
+		}
+		if plain.RetryPolicy != defaults.RetryPolicy || plain.UseSSL != defaults.UseSSL || plain.Region != defaults.Region {
+			t.Errorf("constructor defaults = %+v/%t/%q, want %+v/%t/%q", plain.RetryPolicy, plain.UseSSL, plain.Region, defaults.RetryPolicy, defaults.UseSSL, defaults.Region)
+		}
+	})
+	t.Run("file_bucket", func(t *testing.T) {
+		defaults := FileBucketDefaults()
+		dir := t.TempDir()
+		plain, err := NewFileBucketConfig(NewFileBucketOption().SetBasePath(dir))
+		if err != nil {
+			t.Fatal(err)
+		}
+		seeded, err := NewFileBucketConfig(NewFileBucketOption().From(defaults).SetBasePath(dir))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(plain, seeded, CmpOptions()); diff != "" {
+			t.Errorf("config seeded with FileBucketDefaults differs (-plain +seeded):\n%s", diff)
+		}
+		if plain.TempSuffix != defaults.TempSuffix || plain.MaxTotalSize != defaults.MaxTotalSize {
+			t.Errorf("constructor defaults = %q/%d, want %q/%d", plain.TempSuffix, plain.MaxTotalSize, defaults.TempSuffix, defaults.MaxTotalSize)
+		}
+	})
+}
+
+func TestMinioOptionBuilderFrom(t *testing.T) {
+	config, err := NewMinioConfig(NewMinioOption().From(MinioOption{
+		Endpoint:         "minio.local:9443",
+		AccessKey:        "access-key",
+		SecretKey:        "secret-key",
+		UseSSL:           true,
+		BucketName:       "blobs",
+		Region:           "eu-west-1",
+		MaxPresignExpiry: time.Hour,
+		KeyPrefix:        "uploads/",
+		PathStyle:        true,
+		MaxRetries:       3,
+	}))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v", err)
+	}
+	if config.Endpoint != "minio.local:9443" || config.AccessKey != "access-key" || config.SecretKey != "secret-key" ||
+		!config.UseSSL || config.BucketName != "blobs" || config.Region != "eu-west-1" || config.MaxPresignExpiry != time.Hour ||
+		config.KeyPrefix != "uploads/" || !config.PathStyle || config.MaxRetries != 3 {
+		t.Errorf("config = %+v, want the values passed to From", config)
+	}
+}
+
+func TestFileBucketOptionBuilderFrom(t *testing.T) {
+	dir := t.TempDir()
+	config, err := NewFileBucketConfig(NewFileBucketOption().From(FileBucketOption{
+		BasePath:     dir,
+		TempSuffix:   ".partial",
+		MaxTotalSize: 1 << 20,
+		MaxFileSize:  1 << 10,
+		ShardDepth:   2,
+	}))
+	if err != nil {
+		t.Fatalf("NewFileBucketConfig() error = %v", err)
+	}
+	if config.BasePath != dir || config.TempSuffix != ".partial" || config.MaxTotalSize != 1<<20 || config.MaxFileSize != 1<<10 || config.ShardDepth != 2 {
+		t.Errorf("config = %+v, want the values passed to From", config)
+	}
+}
@@ -0,0 +1,105 @@
+package alex
+
+import "strings"
+
+// Validation rules reported by ValidationError.Rule.
+const (
+	RuleRequired = "required" // RuleRequired is reported when a required field is empty.
+	RuleMin      = "min"      // RuleMin is reported when a value is below its lower bound.
+	RuleMax      = "max"      // RuleMax is reported when a value exceeds its upper bound.
+	RuleFormat   = "format"   // RuleFormat is reported when a value is malformed (e.g., an invalid "host:port" or JSON).
+	RuleConflict = "conflict" // RuleConflict is reported when fields are set to mutually incompatible values.
+)
+
+// ErrorCode is a machine-readable code classifying a validation failure, for alerting on specific misconfigurations.
+type ErrorCode string
+
+// Error codes reported by ValidationError.Code.
+const (
+	ErrCodeMissingField       ErrorCode = "missing_field"       // ErrCodeMissingField is reported when a required field without a dedicated code is empty.
+	ErrCodeInvalidField       ErrorCode = "invalid_field"       // ErrCodeInvalidField is reported when a field without a dedicated code is invalid.
+	ErrCodeMissingEndpoint    ErrorCode = "missing_endpoint"    // ErrCodeMissingEndpoint is reported when no endpoint is set.
+	ErrCodeInvalidEndpoint    ErrorCode = "invalid_endpoint"    // ErrCodeInvalidEndpoint is reported when an endpoint is malformed or conflicts with the SSL setting.
+	ErrCodeMissingBucket      ErrorCode = "missing_bucket"      // ErrCodeMissingBucket is reported when the bucket name is empty.
+	ErrCodeInvalidBucket      ErrorCode = "invalid_bucket"      // ErrCodeInvalidBucket is reported when the bucket name is invalid.
+	ErrCodeMissingAddress     ErrorCode = "missing_address"     // ErrCodeMissingAddress is reported when a server address is empty.
+	ErrCodeInvalidAddress     ErrorCode = "invalid_address"     // ErrCodeInvalidAddress is reported when a server address is not a valid "host:port".
+	ErrCodeMissingCredentials ErrorCode = "missing_credentials" // ErrCodeMissingCredentials is reported when a key or password is empty.
+	ErrCodeInvalidCredentials ErrorCode = "invalid_credentials" // ErrCodeInvalidCredentials is reported when a key or password is too short or set twice.
+	ErrCodeMissingURL         ErrorCode = "missing_url"         // ErrCodeMissingURL is reported when a connection or callback URL is empty.
+	ErrCodeInvalidURL         ErrorCode = "invalid_url"         // ErrCodeInvalidURL is reported when a connection or callback URL is malformed.
+)
+
+// fieldErrorCodes maps the top-level field of a validation failure to its missing and invalid codes.
+// Fields not listed report ErrCodeMissingField or ErrCodeInvalidField.
+var fieldErrorCodes = map[string][2]ErrorCode{
+	"Endpoint":      {ErrCodeMissingEndpoint, ErrCodeInvalidEndpoint},
+	"Endpoints":     {ErrCodeMissingEndpoint, ErrCodeInvalidEndpoint},
+	"BucketName":    {ErrCodeMissingBucket, ErrCodeInvalidBucket},
+	"Addr":          {ErrCodeMissingAddress, ErrCodeInvalidAddress},
+	"SentinelAddrs": {ErrCodeMissingAddress, ErrCodeInvalidAddress},
+	"Servers":       {ErrCodeMissingAddress, ErrCodeInvalidAddress},
+	"AccessKey":     {ErrCodeMissingCredentials, ErrCodeInvalidCredentials},
+	"AccessKeyFile": {ErrCodeMissingCredentials, ErrCodeInvalidCredentials},
+	"SecretKey":     {ErrCodeMissingCredentials, ErrCodeInvalidCredentials},
+	"SecretKeyFile": {ErrCodeMissingCredentials, ErrCodeInvalidCredentials},
+	"PasswordFile":  {ErrCodeMissingCredentials, ErrCodeInvalidCredentials},
+	"URL":           {ErrCodeMissingURL, ErrCodeInvalidURL},
+}
+
+// validationErrorCode returns the code of a validation failure from its field path and rule:
+// the missing code of the top-level field for RuleRequired, and its invalid code otherwise.
+func validationErrorCode(field, rule string) ErrorCode {
+	top, _, _ := strings.Cut(field, ".")
+	top, _, _ = strings.Cut(top, "[")
+	codes, ok := fieldErrorCodes[top]
+	if !ok {
+		codes = [2]ErrorCode{ErrCodeMissingField, ErrCodeInvalidField}
+	}
+	if rule == RuleRequired {
+		return codes[0]
+	}
+	return codes[1]
+}
+
+// ValidationError describes a configuration field that failed validation.
+// It lets programmatic consumers (e.g., a config UI) highlight the exact offending field,
+// while Error renders the same human readable message as before.
+type ValidationError struct {
+	Field   string    // Field is the path of the offending field (e.g., "Addr", "SentinelAddrs[1]", "RetryPolicy.MaxDelay").
+	Rule    string    // Rule is the violated rule, one of the Rule* constants.
+	Message string    // Message is the human readable description of the failure.
+	Code    ErrorCode // Code is the machine-readable classification of the failure, one of the ErrCode* constants.
+}
+
+// Error returns the human readable message of the validation failure.
+//
+// Returns:
+//   - string: The validation message
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// newValidationError creates a ValidationError for the given field, rule, and message,
+// classified with the code derived from the field and rule.
+func newValidationError(field, rule, message string) *ValidationError {
+	return &ValidationError{Field: field, Rule: rule, Message: message, Code: validationErrorCode(field, rule)}
+}
+
+// nestValidationError prefixes the field path and message of a ValidationError returned by a nested value,
+// such as an embedded RetryPolicy. Errors of other types are returned unchanged.
+//
+// Parameters:
+//   - err: The error returned by the nested validation
+//   - field: The field holding the nested value (e.g., "RetryPolicy")
+//   - prefix: The message prefix naming the backend (e.g., "redis")
+//
+// Returns:
+//   - error: The nested error with its field path and message prefixed
+func nestValidationError(err error, field, prefix string) error {
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		return err
+	}
+	return newValidationError(field+"."+ve.Field, ve.Rule, prefix+" "+ve.Message)
+}
@@ -0,0 +1,112 @@
+package alex
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// requireValidationError fails the test unless err is a *ValidationError for the given field and rule.
+func requireValidationError(t *testing.T, err error, field, rule string) *ValidationError {
+	t.Helper()
+	var ve *ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("error = %v, want a *ValidationError for %s", err, field)
+	}
+	if ve.Field != field || ve.Rule != rule {
+		t.Fatalf("validation error = %s/%s (%q), want %s/%s", ve.Field, ve.Rule, ve.Message, field, rule)
+	}
+	return ve
+}
+
+func TestValidationErrorFields(t *testing.T) {
+	redis := func() *RedisConfigOptionsBuilder { return NewRedisConfigOptions().SetAddr("localhost:6379") }
+	tests := []struct {
+		name  string
+		build func() error
+		field string
+		rule  string
+		code  ErrorCode
+	}{
+		{name: "redis_addr", build: func() error { _, err := NewRedisConfig(NewRedisConfigOptions()); return err }, field: "Addr", rule: RuleRequired, code: ErrCodeMissingAddress},
+		{name: "redis_addr_format", build: func() error { _, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("localhost")); return err }, field: "Addr", rule: RuleFormat, code: ErrCodeInvalidAddress},
+		{name: "redis_db", build: func() error { _, err := NewRedisConfig(redis().SetDB(-1)); return err }, field: "DB", rule: RuleMin, code: ErrCodeInvalidField},
+		{name: "redis_read_timeout", build: func() error { _, err := NewRedisConfig(redis().SetReadTimeout(-time.Second)); return err }, field: "ReadTimeout", rule: RuleMin, code: ErrCodeInvalidField},
+		{name: "redis_max_pipeline_length", build: func() error { _, err := NewRedisConfig(redis().SetMaxPipelineLength(-1)); return err }, field: "MaxPipelineLength", rule: RuleMin, code: ErrCodeInvalidField},
+		{name: "redis_default_tx_timeout", build: func() error { _, err := NewRedisConfig(redis().SetDefaultTxTimeout(-time.Second)); return err }, field: "DefaultTxTimeout", rule: RuleMin, code: ErrCodeInvalidField},
+		{name: "redis_pool_size", build: func() error { _, err := NewRedisConfig(redis().SetPoolSize(-1)); return err }, field: "PoolSize", rule: RuleMin, code: ErrCodeInvalidField},
+		{name: "redis_warmup_conns", build: func() error { _, err := NewRedisConfig(redis().SetWarmupConns(-1)); return err }, field: "WarmupConns", rule: RuleMin, code: ErrCodeInvalidField},
+		{name: "redis_warmup_conns_pool", build: func() error { _, err := NewRedisConfig(redis().SetPoolSize(2).SetWarmupConns(3)); return err }, field: "WarmupConns", rule: RuleMax, code: ErrCodeInvalidField},
+		{name: "redis_retry_policy", build: func() error {
+			_, err := NewRedisConfig(redis().SetRetryPolicy(RetryPolicy{MaxAttempts: -1}))
+			return err
+		}, field: "RetryPolicy.MaxAttempts", rule: RuleMin, code: ErrCodeInvalidField},
+		{name: "sentinel_master_name", build: func() error {
+			_, err := NewRedisSentinelConfig(NewRedisSentinelOptions().SetSentinelAddrs("host1:26379"))
+			return err
+		}, field: "MasterName", rule: RuleRequired, code: ErrCodeMissingField},
+		{name: "sentinel_addrs", build: func() error {
+			_, err := NewRedisSentinelConfig(NewRedisSentinelOptions().SetMasterName("mymaster"))
+			return err
+		}, field: "SentinelAddrs", rule: RuleRequired, code: ErrCodeMissingAddress},
+		{name: "sentinel_addr_format", build: func() error {
+			_, err := NewRedisSentinelConfig(NewRedisSentinelOptions().SetMasterName("mymaster").SetSentinelAddrs("host1:26379", "host2"))
+			return err
+		}, field: "SentinelAddrs[1]", rule: RuleFormat, code: ErrCodeInvalidAddress},
+		{name: "minio_endpoint", build: func() error { _, err := NewMinioConfig(newTestMinioOptions().SetEndpoint("")); return err }, field: "Endpoint", rule: RuleRequired, code: ErrCodeMissingEndpoint},
+		{name: "minio_access_key", build: func() error { _, err := NewMinioConfig(newTestMinioOptions().SetAccessKey("")); return err }, field: "AccessKey", rule: RuleRequired, code: ErrCodeMissingCredentials},
+		{name: "minio_secret_key", build: func() error { _, err := NewMinioConfig(newTestMinioOptions().SetSecretKey("")); return err }, field: "SecretKey", rule: RuleRequired, code: ErrCodeMissingCredentials},
+		{name: "minio_bucket_name", build: func() error { _, err := NewMinioConfig(newTestMinioOptions().SetBucketName("")); return err }, field: "BucketName", rule: RuleRequired, code: ErrCodeMissingBucket},
+		{name: "minio_region", build: func() error {
+			_, err := NewMinioConfig(newTestMinioOptions().SetRegion("us-east-1").SetAutoDetectRegion(true))
+			return err
+		}, field: "Region", rule: RuleConflict, code: ErrCodeInvalidField},
+		{name: "minio_region_required", build: func() error { _, err := NewMinioConfig(newTestMinioOptions().SetRequireRegion(true)); return err }, field: "Region", rule: RuleRequired, code: ErrCodeMissingField},
+		{name: "minio_max_presign_expiry", build: func() error {
+			_, err := NewMinioConfig(newTestMinioOptions().SetMaxPresignExpiry(-time.Second))
+			return err
+		}, field: "MaxPresignExpiry", rule: RuleMin, code: ErrCodeInvalidField},
+		{name: "minio_bucket_policy", build: func() error { _, err := NewMinioConfig(newTestMinioOptions().SetBucketPolicy("{")); return err }, field: "BucketPolicy", rule: RuleFormat, code: ErrCodeInvalidField},
+		{name: "minio_expire_after_days", build: func() error { _, err := NewMinioConfig(newTestMinioOptions().SetExpireAfterDays(-1)); return err }, field: "ExpireAfterDays", rule: RuleMin, code: ErrCodeInvalidField},
+		{name: "minio_endpoint_scheme", build: func() error {
+			_, err := NewMinioConfig(newTestMinioOptions().SetStrictScheme(true).SetUseSSL(true).SetEndpoint("localhost:80"))
+			return err
+		}, field: "Endpoint", rule: RuleConflict, code: ErrCodeInvalidEndpoint},
+		{name: "minio_endpoints_conflict", build: func() error {
+			_, err := NewMinioConfig(newTestMinioOptions().AddEndpoint("minio-2.example.com:9000"))
+			return err
+		}, field: "Endpoints", rule: RuleConflict, code: ErrCodeInvalidEndpoint},
+		{name: "minio_short_access_key", build: func() error { _, err := NewMinioConfig(newTestMinioOptions().SetAccessKey("ab")); return err }, field: "AccessKey", rule: RuleMin, code: ErrCodeInvalidCredentials},
+		{name: "file_bucket_base_path", build: func() error { _, err := NewFileBucketConfig(NewFileBucketOption()); return err }, field: "BasePath", rule: RuleRequired, code: ErrCodeMissingField},
+		{name: "memcached_servers", build: func() error { _, err := NewMemcachedConfig(NewMemcachedOptions()); return err }, field: "Servers", rule: RuleRequired, code: ErrCodeMissingAddress},
+		{name: "webhook_url", build: func() error { _, err := NewWebhookConfig(NewWebhookOptions()); return err }, field: "URL", rule: RuleRequired, code: ErrCodeMissingURL},
+		{name: "webhook_url_format", build: func() error { _, err := NewWebhookConfig(NewWebhookOptions().SetURL("/events")); return err }, field: "URL", rule: RuleFormat, code: ErrCodeInvalidURL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ve := requireValidationError(t, fmt.Errorf("wrapped: %w", tt.build()), tt.field, tt.rule)
+			if ve.Code != tt.code {
+				t.Errorf("Code = %q, want %q", ve.Code, tt.code)
+			}
+			if ve.Error() != ve.Message {
+				t.Errorf("Error() = %q, want the message %q", ve.Error(), ve.Message)
+			}
+		})
+	}
+}
+
+func TestNestValidationError(t *testing.T) {
+	err := nestValidationError(newValidationError("MaxDelay", RuleMin, "retry policy max delay is negative"), "RetryPolicy", "minio")
+	ve := requireValidationError(t, err, "RetryPolicy.MaxDelay", RuleMin)
+	if ve.Message != "minio retry policy max delay is negative" {
+		t.Errorf("Message = %q, want the backend prefix", ve.Message)
+	}
+	if ve.Code != ErrCodeInvalidField {
+		t.Errorf("Code = %q, want %q", ve.Code, ErrCodeInvalidField)
+	}
+	plain := errors.New("boom")
+	if got := nestValidationError(plain, "RetryPolicy", "minio"); got != plain {
+		t.Errorf("nestValidationError() = %v, want the error unchanged", got)
+	}
+}
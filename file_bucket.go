@@ -1,14 +1,32 @@
 package alex
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
 
 	"github.com/zeroxsolutions/strike/builderutil"
 )
 
+// MaxFileBucketShardDepth is the maximum number of shard directory levels accepted for ShardDepth.
+const MaxFileBucketShardDepth = 4
+
 // NewFileBucketConfig creates a new FileBucketConfig from FileBucketOption by applying the provided option functions.
 // It uses the builderutil package to build the configuration options in a functional options pattern,
 // then creates and returns a final FileBucketConfig instance.
+// When the backend is disabled via SetEnabled(false), validation is skipped and an empty
+// FileBucketConfig with Enabled set to false is returned.
 //
 // Parameters:
 //   - opts: Variable number of option functions that configure the FileBucketOption
@@ -26,14 +44,503 @@ import (
 //	}
 //	fmt.Printf("File Bucket Config: %+v\n", config)
 func NewFileBucketConfig(opts ...builderutil.Lister[FileBucketOption]) (*FileBucketConfig, error) {
+	return newFileBucketConfig(context.Background(), opts)
+}
+
+// newFileBucketConfig implements NewFileBucketConfig and BuildContext.
+// The build is purely in-memory, so the context is only checked before build hooks are notified.
+//
+// Parameters:
+//   - ctx: The context bounding the construction
+//   - opts: The option functions that configure the FileBucketOption
+//
+// Returns:
+//   - *FileBucketConfig: A pointer to the final FileBucket configuration instance
+//   - error: An error if ctx is done, or if the configuration building process or validation fails
+func newFileBucketConfig(ctx context.Context, opts []builderutil.Lister[FileBucketOption]) (*FileBucketConfig, error) {
 	options, err := builderutil.Build(opts...)
 	if err != nil {
 		return nil, err
 	}
-	if options.BasePath == "" {
-		return nil, errors.New("file bucket base path is required")
+	if options.Enabled != nil && !*options.Enabled {
+		return &FileBucketConfig{Enabled: false}, nil
+	}
+	if options.TrimSpace {
+		trimSpace(&options.BasePath, &options.TempSuffix)
+		options.AllowedExtensions = trimSpaceAll(options.AllowedExtensions)
+	}
+	config := &FileBucketConfig{
+		BasePath:          options.BasePath,
+		Enabled:           true,
+		TempSuffix:        options.TempSuffix,
+		MaxTotalSize:      options.MaxTotalSize,
+		MaxFileSize:       options.MaxFileSize,
+		AllowedExtensions: options.AllowedExtensions,
+		Extra:             options.Extra,
+		CreateIfMissing:   options.CreateIfMissing,
+		ShardDepth:        options.ShardDepth,
+	}
+	if config.TempSuffix == "" {
+		config.TempSuffix = DefaultTempSuffix
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	notifyBuild(config)
+	return config, nil
+}
+
+// BuildContext builds the FileBucket configuration like NewFileBucketConfig, returning the context error
+// without notifying build hooks when ctx is done. The build is purely in-memory and is not interrupted.
+//
+// Parameters:
+//   - ctx: The context bounding the construction
+//
+// Returns:
+//   - *FileBucketConfig: A pointer to the final FileBucket configuration instance
+//   - error: An error if ctx is done before the build completes, or if building or validation fails
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	config, err := NewFileBucketOption().SetBasePath("/var/data").BuildContext(ctx)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (builder *FileBucketOptionBuilder) BuildContext(ctx context.Context) (*FileBucketConfig, error) {
+	return newFileBucketConfig(ctx, []builderutil.Lister[FileBucketOption]{builder})
+}
+
+// RequiredFileBucketFields returns the names of the FileBucketConfig fields that NewFileBucketConfig requires to be set,
+// matching the Field of the RuleRequired validation errors reported when they are empty.
+// TempSuffix is not listed, as the constructor defaults it to DefaultTempSuffix.
+// A new slice is returned on every call, so callers may modify it.
+//
+// Returns:
+//   - []string: The required field names, in the order they are validated
+func RequiredFileBucketFields() []string {
+	return []string{"BasePath"}
+}
+
+// Validate checks that the FileBucketConfig satisfies the rules enforced by its constructor.
+// It performs no I/O, so it can be used to re-check a configuration at any time.
+//
+// Returns:
+//   - error: A *ValidationError describing the first rule the configuration violates, or nil if it is valid
+func (c *FileBucketConfig) Validate() error {
+	if c.BasePath == "" {
+		return newValidationError("BasePath", RuleRequired, "file bucket base path is required")
+	}
+	if c.TempSuffix == "" {
+		return newValidationError("TempSuffix", RuleRequired, "file bucket temp suffix is required")
+	}
+	if strings.ContainsAny(c.TempSuffix, `/\`) {
+		return newValidationError("TempSuffix", RuleFormat, "file bucket temp suffix must not contain path separators")
+	}
+	if c.MaxTotalSize < 0 {
+		return newValidationError("MaxTotalSize", RuleMin, "file bucket max total size must be greater than or equal to 0")
+	}
+	if c.MaxFileSize < 0 {
+		return newValidationError("MaxFileSize", RuleMin, "file bucket max file size must be greater than or equal to 0")
+	}
+	if c.ShardDepth < 0 {
+		return newValidationError("ShardDepth", RuleMin, "file bucket shard depth must be greater than or equal to 0")
+	}
+	if c.ShardDepth > MaxFileBucketShardDepth {
+		return newValidationError("ShardDepth", RuleMax, fmt.Sprintf("file bucket shard depth must be at most %d", MaxFileBucketShardDepth))
+	}
+	if err := validateExtra(c.Extra, "file bucket"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Labels returns identifying, non-secret key/value pairs describing the configuration.
+//
+// Returns:
+//   - map[string]string: The "kind" and "base_path" labels
+func (c *FileBucketConfig) Labels() map[string]string {
+	return map[string]string{
+		"kind":      "file_bucket",
+		"base_path": c.BasePath,
+	}
+}
+
+// LogValue implements slog.LogValuer, so that logging the configuration with log/slog
+// (e.g., slog.Info("config", "filebucket", cfg)) emits a group of its main fields.
+// The file bucket holds no secrets, so nothing is redacted. Extra flags are not logged.
+//
+// Returns:
+//   - slog.Value: A group value holding the logged fields
+func (c *FileBucketConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("base_path", c.BasePath),
+		slog.Int64("max_total_size", c.MaxTotalSize),
+		slog.Int64("max_file_size", c.MaxFileSize),
+		slog.Int("shard_depth", c.ShardDepth),
+		slog.Bool("create_if_missing", c.CreateIfMissing),
+		slog.Bool("enabled", c.Enabled),
+	)
+}
+
+// Resolve returns the filesystem path of name within the file bucket.
+// The name is interpreted relative to BasePath using forward slashes, and is rejected
+// when it is absolute or escapes BasePath (e.g., "../etc/passwd").
+// When ShardDepth is set, the path is placed under the shard directories of ShardPath.
+//
+// Parameters:
+//   - name: The slash-separated path of the file relative to BasePath
+//
+// Returns:
+//   - string: The path of the file on the filesystem
+//   - error: An error if name is not a local path within the file bucket
+func (c *FileBucketConfig) Resolve(name string) (string, error) {
+	local := filepath.FromSlash(name)
+	if !filepath.IsLocal(local) {
+		return "", fmt.Errorf("file bucket path %q escapes the base path", name)
+	}
+	return filepath.Join(c.BasePath, filepath.FromSlash(c.ShardPath(name))), nil
+}
+
+// ShardPath returns the slash-separated path of name relative to BasePath, including its shard directories.
+// Each of the ShardDepth levels is named after the next byte of the SHA-256 hash of name, in hexadecimal,
+// so that files spread evenly over up to 256 directories per level. Without sharding, name is returned unchanged.
+//
+// Parameters:
+//   - name: The slash-separated path of the file relative to BasePath
+//
+// Returns:
+//   - string: The sharded path (e.g., "3f/a2/reports/2024.pdf" with a depth of 2)
+func (c *FileBucketConfig) ShardPath(name string) string {
+	if c.ShardDepth <= 0 {
+		return name
+	}
+	sum := sha256.Sum256([]byte(name))
+	digest := hex.EncodeToString(sum[:c.ShardDepth])
+	parts := make([]string, 0, c.ShardDepth+1)
+	for i := 0; i < c.ShardDepth; i++ {
+		parts = append(parts, digest[2*i:2*i+2])
+	}
+	return path.Join(append(parts, name)...)
+}
+
+// List returns the paths of the files under BasePath whose relative path starts with prefix.
+// Subdirectories are walked recursively and the returned paths are slash-separated and relative
+// to BasePath, in lexical order. A prefix escaping BasePath is rejected.
+// When ShardDepth is set, the shard directories are stripped, so the returned names (and the prefix they are
+// matched against) are the logical names accepted by Resolve, ReadFile, and Move.
+//
+// Parameters:
+//   - prefix: The prefix the relative paths must start with (empty matches every file)
+//
+// Returns:
+//   - []string: The relative paths of the matching files
+//   - error: An error if the prefix escapes the file bucket or the directory tree cannot be walked
+//
+// Example:
+//
+//	files, err := config.List("images/")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *FileBucketConfig) List(prefix string) ([]string, error) {
+	if prefix != "" {
+		if _, err := c.Resolve(prefix); err != nil {
+			return nil, err
+		}
+	}
+	var files []string
+	err := filepath.WalkDir(c.BasePath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(c.BasePath, path)
+		if err != nil {
+			return err
+		}
+		rel, ok := c.unshardPath(filepath.ToSlash(rel))
+		if d.IsDir() {
+			if ok && !strings.HasPrefix(rel+"/", prefix) && !strings.HasPrefix(prefix, rel+"/") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if ok && strings.HasPrefix(rel, prefix) {
+			files = append(files, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if c.ShardDepth > 0 {
+		slices.Sort(files)
+	}
+	return files, nil
+}
+
+// unshardPath strips the ShardDepth leading shard directories from the slash-separated path rel.
+// It reports false when rel is BasePath itself or lies within the shard directories.
+func (c *FileBucketConfig) unshardPath(rel string) (string, bool) {
+	if rel == "." {
+		return "", false
+	}
+	parts := strings.SplitN(rel, "/", c.ShardDepth+1)
+	if len(parts) <= c.ShardDepth {
+		return "", false
+	}
+	return parts[c.ShardDepth], true
+}
+
+// TempFileName returns the temporary name under which finalName is written before being renamed into place.
+// The temporary file lives next to the final one, so the rename stays on the same filesystem.
+// When ShardDepth is set, the temporary file is placed in the shard directories of finalName rather than
+// those of its own name, and the returned path includes them like ShardPath.
+//
+// Parameters:
+//   - finalName: The name of the final file
+//
+// Returns:
+//   - string: The slash-separated path relative to BasePath of the final file, with TempSuffix appended
+//
+// Example:
+//
+//	tmp := config.TempFileName("reports/2024.pdf") // "reports/2024.pdf.tmp", or "f8/ec/reports/2024.pdf.tmp" with a depth of 2
+func (c *FileBucketConfig) TempFileName(finalName string) string {
+	return path.Join(path.Dir(c.ShardPath(finalName)), path.Base(finalName)+c.TempSuffix)
+}
+
+// UsedBytes returns the total size of the regular files under BasePath, in bytes.
+// It is UsageContext without cancellation.
+//
+// Returns:
+//   - int64: The summed size of the files
+//   - error: An error if the directory tree cannot be walked
+func (c *FileBucketConfig) UsedBytes() (int64, error) {
+	return c.UsageContext(context.Background())
+}
+
+// UsageContext returns the total size of the regular files under BasePath, in bytes, aborting
+// the walk as soon as ctx is done. Symbolic links are neither followed nor counted, so link
+// cycles cannot make the walk loop.
+//
+// Parameters:
+//   - ctx: The context bounding the walk
+//
+// Returns:
+//   - int64: The summed size of the files
+//   - error: The context error if ctx is done before the walk completes, or an error if the directory tree cannot be walked
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	used, err := config.UsageContext(ctx)
+func (c *FileBucketConfig) UsageContext(ctx context.Context) (int64, error) {
+	var used int64
+	err := filepath.WalkDir(c.BasePath, func(path string, d fs.DirEntry, err error) error {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		used += info.Size()
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return used, nil
+}
+
+// CheckQuota reports whether writing size more bytes would exceed MaxTotalSize.
+// Writers call it before storing a file; when MaxTotalSize is 0, every write is allowed.
+// It is CheckQuotaContext without cancellation.
+//
+// Parameters:
+//   - size: The number of bytes about to be written
+//
+// Returns:
+//   - error: An error if the write would exceed the quota or the used size cannot be computed
+//
+// Example:
+//
+//	if err := config.CheckQuota(int64(len(data))); err != nil {
+//	    return err
+//	}
+func (c *FileBucketConfig) CheckQuota(size int64) error {
+	return c.CheckQuotaContext(context.Background(), size)
+}
+
+// CheckQuotaContext reports whether writing size more bytes would exceed MaxTotalSize,
+// computing the used size with UsageContext so that the walk stops once ctx is done.
+//
+// Parameters:
+//   - ctx: The context bounding the walk
+//   - size: The number of bytes about to be written
+//
+// Returns:
+//   - error: An error if the write would exceed the quota, or the used size cannot be computed before ctx is done
+func (c *FileBucketConfig) CheckQuotaContext(ctx context.Context, size int64) error {
+	if c.MaxTotalSize == 0 {
+		return nil
+	}
+	used, err := c.UsageContext(ctx)
+	if err != nil {
+		return err
+	}
+	if used+size > c.MaxTotalSize {
+		return fmt.Errorf("file bucket quota exceeded: writing %d bytes on top of %d used exceeds the %d byte limit", size, used, c.MaxTotalSize)
+	}
+	return nil
+}
+
+// ReadFile reads the file name, slash-separated and relative to BasePath, and returns its contents.
+// The path is traversal-checked with Resolve. When MaxFileSize is set, a larger file is refused
+// before any of it is read, and a file growing past the limit while being read is refused as well.
+//
+// Parameters:
+//   - name: The path of the file to read
+//
+// Returns:
+//   - []byte: The contents of the file
+//   - error: An error if name escapes the file bucket, the file exceeds MaxFileSize, or it cannot be read
+//
+// Example:
+//
+//	data, err := config.ReadFile("reports/2024.pdf")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *FileBucketConfig) ReadFile(name string) ([]byte, error) {
+	path, err := c.Resolve(name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if c.MaxFileSize == 0 {
+		return io.ReadAll(f)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > c.MaxFileSize {
+		return nil, fmt.Errorf("file bucket file %q is %d bytes, exceeding the %d byte limit", name, info.Size(), c.MaxFileSize)
+	}
+	data, err := io.ReadAll(io.LimitReader(f, c.MaxFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > c.MaxFileSize {
+		return nil, fmt.Errorf("file bucket file %q exceeds the %d byte limit", name, c.MaxFileSize)
+	}
+	return data, nil
+}
+
+// AllowsFile reports whether name has one of the AllowedExtensions, compared case-insensitively.
+// Every file is allowed when AllowedExtensions is empty.
+//
+// Parameters:
+//   - name: The file name or path to check
+//
+// Returns:
+//   - bool: true if the file extension is allowed
+//
+// Example:
+//
+//	if !config.AllowsFile(header.Filename) {
+//	    return errors.New("file type not allowed")
+//	}
+func (c *FileBucketConfig) AllowsFile(name string) bool {
+	if len(c.AllowedExtensions) == 0 {
+		return true
+	}
+	ext := strings.ToLower(filepath.Ext(name))
+	return ext != "" && slices.Contains(c.AllowedExtensions, ext)
+}
+
+// Move moves the file src to dst within the file bucket, both slash-separated and relative to BasePath.
+// Both paths are traversal-checked with Resolve. The destination directory is created when
+// CreateIfMissing is set. The file is renamed, falling back to a copy followed by a removal of src
+// when the paths are on different filesystems.
+//
+// Parameters:
+//   - src: The path of the file to move
+//   - dst: The path the file is moved to
+//
+// Returns:
+//   - error: An error if either path escapes the file bucket or the file cannot be moved
+//
+// Example:
+//
+//	if err := config.Move("incoming/report.pdf", "archive/2024/report.pdf"); err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *FileBucketConfig) Move(src, dst string) error {
+	srcPath, err := c.Resolve(src)
+	if err != nil {
+		return err
+	}
+	dstPath, err := c.Resolve(dst)
+	if err != nil {
+		return err
+	}
+	if c.CreateIfMissing {
+		if err := os.MkdirAll(filepath.Dir(dstPath), 0o755); err != nil {
+			return err
+		}
+	}
+	err = os.Rename(srcPath, dstPath)
+	if !errors.Is(err, syscall.EXDEV) {
+		return err
+	}
+	if err := copyFile(srcPath, dstPath); err != nil {
+		return err
+	}
+	return os.Remove(srcPath)
+}
+
+// copyFile copies the regular file src to dst, preserving its permission bits.
+// A partially written dst is removed when the copy fails.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(dst)
+		return err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(dst)
+		return err
 	}
-	return &FileBucketConfig{
-		BasePath: options.BasePath,
-	}, nil
+	return nil
 }
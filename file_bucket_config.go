@@ -1,9 +1,27 @@
 package alex
 
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// DefaultTempSuffix is the suffix of temporary files used for atomic writes when none is configured.
+const DefaultTempSuffix = ".tmp"
+
 // FileBucketOption represents the configuration options for a file bucket.
 // It includes the base path of the file bucket.
 type FileBucketOption struct {
-	BasePath string // BasePath is the base path of the file bucket.
+	BasePath          string            // BasePath is the base path of the file bucket.
+	Enabled           *bool             // Enabled is a flag indicating whether the file bucket backend is used (nil means enabled).
+	TempSuffix        string            // TempSuffix is the suffix of temporary files written before being renamed (defaults to DefaultTempSuffix).
+	MaxTotalSize      int64             // MaxTotalSize is the maximum total size of the files under BasePath, in bytes (0 means no limit).
+	MaxFileSize       int64             // MaxFileSize is the maximum size of a file read with ReadFile, in bytes (0 means no limit).
+	AllowedExtensions []string          // AllowedExtensions are the lowercase file extensions accepted by AllowsFile, with a leading dot (empty allows all).
+	CreateIfMissing   bool              // CreateIfMissing is a flag indicating whether missing directories are created when moving files.
+	ShardDepth        int               // ShardDepth is the number of hash-prefix directories files are sharded into by Resolve (0 disables sharding, at most 4).
+	Extra             map[string]string // Extra holds backend-specific flags without a dedicated field yet; unknown keys are preserved.
+	TrimSpace         bool              // TrimSpace is a flag indicating whether the constructor trims leading and trailing white space from the string fields.
 }
 
 // FileBucketOptionBuilder provides a builder pattern for constructing FileBucketOption.
@@ -38,14 +56,227 @@ func (builder *FileBucketOptionBuilder) SetBasePath(basePath string) *FileBucket
 	return builder
 }
 
+// SetEnabled configures whether the file bucket backend is enabled.
+// It appends an option function that sets the Enabled field of FileBucketOption.
+// Backends are enabled by default; a disabled backend skips validation, so none of its fields are required.
+//
+// Parameters:
+//   - enabled: A flag indicating whether the file bucket backend is used
+//
+// Returns:
+//   - *FileBucketOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewFileBucketOption()
+//	config, err := NewFileBucketConfig(builder.SetEnabled(false))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("File Bucket Config: %+v\n", config)
+func (builder *FileBucketOptionBuilder) SetEnabled(enabled bool) *FileBucketOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *FileBucketOption) error {
+		args.Enabled = &enabled
+		return nil
+	})
+	return builder
+}
+
+// SetTempSuffix configures the suffix of temporary files used for atomic writes.
+// It appends an option function that sets the TempSuffix field of FileBucketOption.
+// Files are written under a temporary name and then renamed; when unset, DefaultTempSuffix is used.
+//
+// Parameters:
+//   - tempSuffix: The suffix of temporary files (must not contain path separators)
+//
+// Returns:
+//   - *FileBucketOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewFileBucketOption()
+//	config, err := NewFileBucketConfig(builder.SetBasePath("basePath").SetTempSuffix(".partial"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("File Bucket Config: %+v\n", config)
+func (builder *FileBucketOptionBuilder) SetTempSuffix(tempSuffix string) *FileBucketOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *FileBucketOption) error {
+		args.TempSuffix = tempSuffix
+		return nil
+	})
+	return builder
+}
+
+// SetMaxTotalSize configures the maximum total size of the files stored in the file bucket.
+// It appends an option function that sets the MaxTotalSize field of FileBucketOption.
+// The quota is checked with CheckQuota before writing; when unset, the size is unlimited.
+//
+// Parameters:
+//   - maxTotalSize: The maximum total size of the files under the base path, in bytes
+//
+// Returns:
+//   - *FileBucketOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewFileBucketOption()
+//	config, err := NewFileBucketConfig(builder.SetBasePath("basePath").SetMaxTotalSize(10 << 30))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("File Bucket Config: %+v\n", config)
+func (builder *FileBucketOptionBuilder) SetMaxTotalSize(maxTotalSize int64) *FileBucketOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *FileBucketOption) error {
+		args.MaxTotalSize = maxTotalSize
+		return nil
+	})
+	return builder
+}
+
+// SetMaxFileSize configures the maximum size of a single file read from the file bucket.
+// It appends an option function that sets the MaxFileSize field of FileBucketOption.
+// ReadFile refuses larger files before reading them; when unset, the size is unlimited.
+//
+// Parameters:
+//   - maxFileSize: The maximum size of a file, in bytes
+//
+// Returns:
+//   - *FileBucketOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewFileBucketOption()
+//	config, err := NewFileBucketConfig(builder.SetBasePath("basePath").SetMaxFileSize(32 << 20))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("File Bucket Config: %+v\n", config)
+func (builder *FileBucketOptionBuilder) SetMaxFileSize(maxFileSize int64) *FileBucketOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *FileBucketOption) error {
+		args.MaxFileSize = maxFileSize
+		return nil
+	})
+	return builder
+}
+
+// AddAllowedExtension adds a file extension to the whitelist checked by AllowsFile.
+// It appends an option function that appends the extension to the AllowedExtensions field of FileBucketOption,
+// normalized to lowercase with a leading dot (e.g., "PNG" becomes ".png"). While no extension is added, every file is allowed.
+//
+// Parameters:
+//   - ext: The file extension to allow, with or without a leading dot
+//
+// Returns:
+//   - *FileBucketOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewFileBucketOption()
+//	config, err := NewFileBucketConfig(builder.SetBasePath("basePath").AddAllowedExtension(".png").AddAllowedExtension("jpg"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("File Bucket Config: %+v\n", config)
+func (builder *FileBucketOptionBuilder) AddAllowedExtension(ext string) *FileBucketOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *FileBucketOption) error {
+		normalized := strings.ToLower(strings.TrimSpace(ext))
+		if normalized == "" || normalized == "." {
+			return errors.New("file bucket allowed extension is empty")
+		}
+		if !strings.HasPrefix(normalized, ".") {
+			normalized = "." + normalized
+		}
+		args.AllowedExtensions = append(args.AllowedExtensions, normalized)
+		return nil
+	})
+	return builder
+}
+
+// SetShardDepth configures the number of directory levels files are sharded into, to avoid huge directories.
+// It appends an option function that sets the ShardDepth field of FileBucketOption.
+// With a depth of 2, Resolve maps a name to "ab/cd/<name>", where "ab" and "cd" are the first bytes
+// of the SHA-256 hash of the name in hexadecimal. The depth must be between 0 (no sharding) and 4.
+//
+// Parameters:
+//   - depth: The number of shard directory levels
+//
+// Returns:
+//   - *FileBucketOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewFileBucketOption()
+//	config, err := NewFileBucketConfig(builder.SetBasePath("basePath").SetShardDepth(2))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("File Bucket Config: %+v\n", config)
+func (builder *FileBucketOptionBuilder) SetShardDepth(depth int) *FileBucketOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *FileBucketOption) error {
+		args.ShardDepth = depth
+		return nil
+	})
+	return builder
+}
+
+// SetCreateIfMissing configures whether missing directories are created when files are moved into them.
+// It appends an option function that sets the CreateIfMissing field of FileBucketOption.
+//
+// Parameters:
+//   - createIfMissing: A flag indicating whether missing destination directories are created
+//
+// Returns:
+//   - *FileBucketOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewFileBucketOption()
+//	config, err := NewFileBucketConfig(builder.SetBasePath("basePath").SetCreateIfMissing(true))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("File Bucket Config: %+v\n", config)
+func (builder *FileBucketOptionBuilder) SetCreateIfMissing(createIfMissing bool) *FileBucketOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *FileBucketOption) error {
+		args.CreateIfMissing = createIfMissing
+		return nil
+	})
+	return builder
+}
+
+// SetExtra sets a backend-specific flag that has no dedicated field yet, as a forward-compatible escape hatch.
+// It appends an option function that adds the key/value pair to the Extra field of FileBucketOption.
+// The constructor does not interpret the flags but preserves them in the final configuration.
+//
+// Parameters:
+//   - key: The flag name (must not be empty); setting an existing key replaces its value
+//   - value: The flag value
+//
+// Returns:
+//   - *FileBucketOptionBuilder: The builder instance for method chaining
+func (builder *FileBucketOptionBuilder) SetExtra(key, value string) *FileBucketOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *FileBucketOption) error {
+		if args.Extra == nil {
+			args.Extra = make(map[string]string)
+		}
+		args.Extra[key] = value
+		return nil
+	})
+	return builder
+}
+
 // List returns the slice of option functions accumulated by the builder.
 // This method implements the builderutil.Lister interface, allowing the builder
 // to be used with the builderutil.Build function.
+// The functions are returned in the order the setters were called and builderutil.Build applies them
+// in that order, so when several setters touch the same field the last call wins. The returned slice
+// is a copy, so callers cannot reorder or drop the queued options.
 //
 // Returns:
 //   - []func(*FileBucketOption) error: A slice of option functions that can be applied to configure FileBucketOption
 func (builder *FileBucketOptionBuilder) List() []func(*FileBucketOption) error {
-	return builder.Opts
+	return slices.Clone(builder.Opts)
 }
 
 // NewFileBucketOption creates and returns a new instance of FileBucketOptionBuilder.
@@ -61,9 +292,83 @@ func NewFileBucketOption() *FileBucketOptionBuilder {
 	return &FileBucketOptionBuilder{}
 }
 
+// SetTrimSpace configures whether leading and trailing white space is trimmed from the string fields before validation.
+// It appends an option function that sets the TrimSpace field of FileBucketOption.
+// Trimming is off by default so that values are used exactly as given; enabling it guards against
+// a base path read from an environment file with a trailing carriage return silently breaking the configuration.
+//
+// Parameters:
+//   - trim: A flag indicating whether the string fields are trimmed
+//
+// Returns:
+//   - *FileBucketOptionBuilder: The builder instance for method chaining
+func (builder *FileBucketOptionBuilder) SetTrimSpace(trim bool) *FileBucketOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *FileBucketOption) error {
+		args.TrimSpace = trim
+		return nil
+	})
+	return builder
+}
+
+// From seeds the builder from an already populated FileBucketOption, such as the one returned by FileBucketDefaults.
+// It enqueues the matching setter for each non-zero field of the given options,
+// which eases migration from manual struct construction to the builder.
+//
+// Parameters:
+//   - o: The options whose non-zero fields should be applied
+//
+// Returns:
+//   - *FileBucketOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewFileBucketOption().From(FileBucketDefaults())
+//	config, err := NewFileBucketConfig(builder.SetBasePath("basePath"))
+func (builder *FileBucketOptionBuilder) From(o FileBucketOption) *FileBucketOptionBuilder {
+	if o.BasePath != "" {
+		builder.SetBasePath(o.BasePath)
+	}
+	if o.Enabled != nil {
+		builder.SetEnabled(*o.Enabled)
+	}
+	if o.TempSuffix != "" {
+		builder.SetTempSuffix(o.TempSuffix)
+	}
+	if o.MaxTotalSize != 0 {
+		builder.SetMaxTotalSize(o.MaxTotalSize)
+	}
+	if o.MaxFileSize != 0 {
+		builder.SetMaxFileSize(o.MaxFileSize)
+	}
+	for _, ext := range o.AllowedExtensions {
+		builder.AddAllowedExtension(ext)
+	}
+	if o.CreateIfMissing {
+		builder.SetCreateIfMissing(o.CreateIfMissing)
+	}
+	if o.ShardDepth != 0 {
+		builder.SetShardDepth(o.ShardDepth)
+	}
+	for key, value := range o.Extra {
+		builder.SetExtra(key, value)
+	}
+	if o.TrimSpace {
+		builder.SetTrimSpace(o.TrimSpace)
+	}
+	return builder
+}
+
 // FileBucketConfig represents the final FileBucket configuration used for using a file bucket.
 // This struct is created from FileBucketOption after validation and contains all the necessary
 // parameters for using a file bucket.
 type FileBucketConfig struct {
-	BasePath string // BasePath is the base path of the file bucket.
+	BasePath          string            // BasePath is the base path of the file bucket.
+	Enabled           bool              // Enabled is a flag indicating whether the file bucket backend is used.
+	TempSuffix        string            // TempSuffix is the suffix of temporary files written before being renamed.
+	MaxTotalSize      int64             // MaxTotalSize is the maximum total size of the files under BasePath, in bytes (0 means no limit).
+	MaxFileSize       int64             // MaxFileSize is the maximum size of a file read with ReadFile, in bytes (0 means no limit).
+	AllowedExtensions []string          // AllowedExtensions are the lowercase file extensions accepted by AllowsFile, with a leading dot (empty allows all).
+	CreateIfMissing   bool              // CreateIfMissing is a flag indicating whether missing directories are created when moving files.
+	ShardDepth        int               // ShardDepth is the number of hash-prefix directories files are sharded into by Resolve (0 disables sharding, at most 4).
+	Extra             map[string]string // Extra holds backend-specific flags without a dedicated field yet; unknown keys are preserved.
 }
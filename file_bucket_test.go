@@ -0,0 +1,477 @@
+package alex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestFileBucket builds a file bucket rooted at a new temporary directory holding the given files,
+// keyed by their slash-separated relative paths.
+func newTestFileBucket(t *testing.T, files map[string]string, opts ...func(*FileBucketOptionBuilder)) *FileBucketConfig {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	builder := NewFileBucketOption().SetBasePath(dir)
+	for _, opt := range opts {
+		opt(builder)
+	}
+	config, err := NewFileBucketConfig(builder)
+	if err != nil {
+		t.Fatalf("NewFileBucketConfig() error = %v", err)
+	}
+	return config
+}
+
+func TestFileBucketConfigList(t *testing.T) {
+	config := newTestFileBucket(t, map[string]string{
+		"readme.txt":             "a",
+		"images/logo.png":        "b",
+		"images/icons/small.png": "c",
+		"images2/banner.png":     "d",
+		"reports/2024/q1.pdf":    "e",
+	})
+	tests := []struct {
+		prefix string
+		want   []string
+	}{
+		{"", []string{"images/icons/small.png", "images/logo.png", "images2/banner.png", "readme.txt", "reports/2024/q1.pdf"}},
+		{"images/", []string{"images/icons/small.png", "images/logo.png"}},
+		{"images", []string{"images/icons/small.png", "images/logo.png", "images2/banner.png"}},
+		{"images/icons/s", []string{"images/icons/small.png"}},
+		{"reports/2024/", []string{"reports/2024/q1.pdf"}},
+		{"missing/", nil},
+	}
+	for _, tt := range tests {
+		got, err := config.List(tt.prefix)
+		if err != nil {
+			t.Errorf("List(%q) error = %v", tt.prefix, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("List(%q) = %v, want %v", tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestFileBucketConfigTraversal(t *testing.T) {
+	config := newTestFileBucket(t, nil)
+	for _, name := range []string{"../etc/passwd", "a/../../b", "/etc/passwd", ".."} {
+		if _, err := config.Resolve(name); err == nil {
+			t.Errorf("Resolve(%q) error = nil, want a traversal error", name)
+		}
+		if _, err := config.List(name); err == nil {
+			t.Errorf("List(%q) error = nil, want a traversal error", name)
+		}
+	}
+	got, err := config.Resolve("images/logo.png")
+	if want := filepath.Join(config.BasePath, "images", "logo.png"); err != nil || got != want {
+		t.Errorf("Resolve(images/logo.png) = %q, %v, want %q", got, err, want)
+	}
+}
+
+func TestFileBucketConfigTempFileName(t *testing.T) {
+	config := newTestFileBucket(t, nil)
+	if config.TempSuffix != ".tmp" {
+		t.Errorf("TempSuffix = %q, want the .tmp default", config.TempSuffix)
+	}
+	if got := config.TempFileName("reports/2024.pdf"); got != "reports/2024.pdf.tmp" {
+		t.Errorf("TempFileName() = %q, want reports/2024.pdf.tmp", got)
+	}
+	config = newTestFileBucket(t, nil, func(b *FileBucketOptionBuilder) { b.SetTempSuffix(".partial") })
+	if got := config.TempFileName("a.txt"); got != "a.txt.partial" {
+		t.Errorf("TempFileName() = %q, want a.txt.partial", got)
+	}
+}
+
+func TestFileBucketConfigTempSuffixValidation(t *testing.T) {
+	for _, suffix := range []string{"/tmp", ".tmp/x", `.tmp\x`} {
+		_, err := NewFileBucketConfig(NewFileBucketOption().SetBasePath(t.TempDir()).SetTempSuffix(suffix))
+		requireValidationError(t, err, "TempSuffix", RuleFormat)
+	}
+	config := newTestFileBucket(t, nil)
+	config.TempSuffix = ""
+	requireValidationError(t, config.Validate(), "TempSuffix", RuleRequired)
+}
+
+func TestFileBucketOptionBuilderBuildContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := NewFileBucketOption().SetBasePath(t.TempDir()).BuildContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("BuildContext() error = %v, want context.Canceled", err)
+	}
+	if _, err := NewFileBucketOption().SetBasePath(t.TempDir()).BuildContext(context.Background()); err != nil {
+		t.Errorf("BuildContext() error = %v", err)
+	}
+}
+
+func TestFileBucketConfigUsedBytes(t *testing.T) {
+	config := newTestFileBucket(t, map[string]string{
+		"a.txt":            "12345",
+		"nested/b.txt":     "1234567890",
+		"nested/deep/c.md": "123",
+	})
+	used, err := config.UsedBytes()
+	if err != nil {
+		t.Fatalf("UsedBytes() error = %v", err)
+	}
+	if used != 18 {
+		t.Errorf("UsedBytes() = %d, want 18", used)
+	}
+}
+
+func TestFileBucketConfigCheckQuota(t *testing.T) {
+	files := map[string]string{"a.txt": "12345", "nested/b.txt": "1234567890"}
+	tests := []struct {
+		name     string
+		maxTotal int64
+		size     int64
+		wantErr  bool
+	}{
+		{name: "unlimited", maxTotal: 0, size: 1 << 40, wantErr: false},
+		{name: "below_quota", maxTotal: 20, size: 4, wantErr: false},
+		{name: "exactly_at_quota", maxTotal: 20, size: 5, wantErr: false},
+		{name: "one_byte_over", maxTotal: 20, size: 6, wantErr: true},
+		{name: "already_over", maxTotal: 10, size: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := newTestFileBucket(t, files, func(b *FileBucketOptionBuilder) { b.SetMaxTotalSize(tt.maxTotal) })
+			err := config.CheckQuota(tt.size)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckQuota(%d) error = %v, wantErr %t", tt.size, err, tt.wantErr)
+			}
+		})
+	}
+	_, err := NewFileBucketConfig(NewFileBucketOption().SetBasePath(t.TempDir()).SetMaxTotalSize(-1))
+	requireValidationError(t, err, "MaxTotalSize", RuleMin)
+}
+
+func TestFileBucketConfigAllowsFile(t *testing.T) {
+	config := newTestFileBucket(t, nil, func(b *FileBucketOptionBuilder) {
+		b.AddAllowedExtension("PNG").AddAllowedExtension(".Jpg").AddAllowedExtension(" gif ")
+	})
+	if want := []string{".png", ".jpg", ".gif"}; !slices.Equal(config.AllowedExtensions, want) {
+		t.Errorf("AllowedExtensions = %v, want %v", config.AllowedExtensions, want)
+	}
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: "logo.png", want: true},
+		{name: "images/PHOTO.JPG", want: true},
+		{name: "anim.GiF", want: true},
+		{name: "script.sh", want: false},
+		{name: "logo.png.exe", want: false},
+		{name: "png", want: false},
+		{name: "Makefile", want: false},
+	}
+	for _, tt := range tests {
+		if got := config.AllowsFile(tt.name); got != tt.want {
+			t.Errorf("AllowsFile(%q) = %t, want %t", tt.name, got, tt.want)
+		}
+	}
+
+	all := newTestFileBucket(t, nil)
+	for _, name := range []string{"script.sh", "Makefile", "logo.png"} {
+		if !all.AllowsFile(name) {
+			t.Errorf("AllowsFile(%q) = false, want every file allowed without a whitelist", name)
+		}
+	}
+
+	for _, ext := range []string{"", ".", "  "} {
+		if _, err := NewFileBucketConfig(NewFileBucketOption().SetBasePath(t.TempDir()).AddAllowedExtension(ext)); err == nil {
+			t.Errorf("AddAllowedExtension(%q) error = nil, want an error", ext)
+		}
+	}
+}
+
+// readBucketFile returns the contents of the file name in config, or "" and false if it does not exist.
+func readBucketFile(t *testing.T, config *FileBucketConfig, name string) (string, bool) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(config.BasePath, filepath.FromSlash(name)))
+	if os.IsNotExist(err) {
+		return "", false
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data), true
+}
+
+func TestFileBucketConfigMove(t *testing.T) {
+	tests := []struct {
+		name            string
+		src, dst        string
+		createIfMissing bool
+		wantErr         bool
+	}{
+		{name: "same_dir_rename", src: "incoming/report.pdf", dst: "incoming/final.pdf"},
+		{name: "cross_subdir", src: "incoming/report.pdf", dst: "archive/report.pdf"},
+		{name: "create_missing_dir", src: "incoming/report.pdf", dst: "archive/2024/q1/report.pdf", createIfMissing: true},
+		{name: "missing_dir", src: "incoming/report.pdf", dst: "archive/2024/q1/report.pdf", wantErr: true},
+		{name: "src_traversal", src: "../outside.pdf", dst: "archive/report.pdf", wantErr: true},
+		{name: "dst_traversal", src: "incoming/report.pdf", dst: "../../outside.pdf", createIfMissing: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := newTestFileBucket(t, map[string]string{
+				"incoming/report.pdf": "pdf",
+				"archive/old.pdf":     "old",
+			}, func(b *FileBucketOptionBuilder) { b.SetCreateIfMissing(tt.createIfMissing) })
+			err := config.Move(tt.src, tt.dst)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Move() error = nil, want an error")
+				}
+				if _, ok := readBucketFile(t, config, "incoming/report.pdf"); !ok {
+					t.Error("source removed by a failed Move")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Move() error = %v", err)
+			}
+			if _, ok := readBucketFile(t, config, tt.src); ok {
+				t.Error("source still exists after Move")
+			}
+			if got, ok := readBucketFile(t, config, tt.dst); !ok || got != "pdf" {
+				t.Errorf("destination = %q (exists %t), want the moved contents", got, ok)
+			}
+		})
+	}
+}
+
+func TestCopyFile(t *testing.T) {
+	dir := t.TempDir()
+	src, dst := filepath.Join(dir, "src"), filepath.Join(dir, "dst")
+	if err := os.WriteFile(src, []byte("contents"), 0o640); err != nil {
+		t.Fatal(err)
+	}
+	if err := copyFile(src, dst); err != nil {
+		t.Fatalf("copyFile() error = %v", err)
+	}
+	data, err := os.ReadFile(dst)
+	if err != nil || string(data) != "contents" {
+		t.Errorf("copy = %q, %v, want the source contents", data, err)
+	}
+	info, err := os.Stat(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o640 {
+		t.Errorf("copy mode = %v, want 0640", info.Mode().Perm())
+	}
+	if err := copyFile(filepath.Join(dir, "missing"), filepath.Join(dir, "other")); err == nil {
+		t.Error("copyFile() of a missing file error = nil")
+	}
+}
+
+func TestFileBucketConfigReadFile(t *testing.T) {
+	config := newTestFileBucket(t, map[string]string{"docs/a.txt": "hello", "docs/b.txt": "0123456789"}, func(b *FileBucketOptionBuilder) {
+		b.SetMaxFileSize(10)
+	})
+	data, err := config.ReadFile("docs/a.txt")
+	if err != nil || string(data) != "hello" {
+		t.Errorf("ReadFile(docs/a.txt) = %q, %v, want hello", data, err)
+	}
+	data, err = config.ReadFile("docs/b.txt")
+	if err != nil || string(data) != "0123456789" {
+		t.Errorf("ReadFile(docs/b.txt) = %q, %v, want a file of exactly MaxFileSize to be read", data, err)
+	}
+
+	// A sparse file is reported at its full size without occupying the disk.
+	f, err := os.Create(filepath.Join(config.BasePath, "large.bin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Truncate(1 << 30); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	if data, err := config.ReadFile("large.bin"); err == nil {
+		t.Errorf("ReadFile(large.bin) = %d bytes, want an error for a file over MaxFileSize", len(data))
+	}
+
+	for _, name := range []string{"../outside.txt", "missing.txt"} {
+		if _, err := config.ReadFile(name); err == nil {
+			t.Errorf("ReadFile(%q) error = nil, want an error", name)
+		}
+	}
+
+	unlimited := newTestFileBucket(t, map[string]string{"big.txt": strings.Repeat("x", 100)})
+	if data, err := unlimited.ReadFile("big.txt"); err != nil || len(data) != 100 {
+		t.Errorf("ReadFile(big.txt) = %d bytes, %v, want 100 bytes without a limit", len(data), err)
+	}
+}
+
+func TestFileBucketConfigShardPath(t *testing.T) {
+	// The SHA-256 hash of "reports/2024.pdf" starts with f8ece433.
+	tests := []struct {
+		depth int
+		want  string
+	}{
+		{depth: 0, want: "reports/2024.pdf"},
+		{depth: 1, want: "f8/reports/2024.pdf"},
+		{depth: 2, want: "f8/ec/reports/2024.pdf"},
+		{depth: 4, want: "f8/ec/e4/33/reports/2024.pdf"},
+	}
+	for _, tt := range tests {
+		config := newTestFileBucket(t, nil, func(b *FileBucketOptionBuilder) { b.SetShardDepth(tt.depth) })
+		if got := config.ShardPath("reports/2024.pdf"); got != tt.want {
+			t.Errorf("ShardPath() with depth %d = %q, want %q", tt.depth, got, tt.want)
+		}
+		resolved, err := config.Resolve("reports/2024.pdf")
+		if err != nil {
+			t.Fatalf("Resolve() error = %v", err)
+		}
+		if want := filepath.Join(config.BasePath, filepath.FromSlash(tt.want)); resolved != want {
+			t.Errorf("Resolve() with depth %d = %q, want %q", tt.depth, resolved, want)
+		}
+	}
+
+	config := newTestFileBucket(t, map[string]string{"f8/ec/reports/2024.pdf": "report"}, func(b *FileBucketOptionBuilder) { b.SetShardDepth(2) })
+	if data, err := config.ReadFile("reports/2024.pdf"); err != nil || string(data) != "report" {
+		t.Errorf("ReadFile() = %q, %v, want the file under its shard directories", data, err)
+	}
+	if _, err := config.Resolve("../outside.txt"); err == nil {
+		t.Error("Resolve(../outside.txt) error = nil, want sharding to keep the traversal check")
+	}
+}
+
+func TestFileBucketConfigListSharded(t *testing.T) {
+	config := newTestFileBucket(t, nil, func(b *FileBucketOptionBuilder) { b.SetShardDepth(2) })
+	files := map[string]string{"readme.txt": "a", "reports/2024.pdf": "b", "reports/2025.pdf": "c", "images/logo.png": "d"}
+	for name, content := range files {
+		path, err := config.Resolve(name)
+		if err != nil {
+			t.Fatalf("Resolve(%q) error = %v", name, err)
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := config.List("")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if want := []string{"images/logo.png", "readme.txt", "reports/2024.pdf", "reports/2025.pdf"}; !slices.Equal(got, want) {
+		t.Fatalf("List() = %v, want the logical names %v", got, want)
+	}
+	for _, name := range got {
+		if data, err := config.ReadFile(name); err != nil || string(data) != files[name] {
+			t.Errorf("ReadFile(%q) = %q, %v, want %q", name, data, err, files[name])
+		}
+	}
+	got, err = config.List("reports/")
+	if want := []string{"reports/2024.pdf", "reports/2025.pdf"}; err != nil || !slices.Equal(got, want) {
+		t.Errorf("List(reports/) = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestFileBucketConfigTempFileNameSharded(t *testing.T) {
+	config := newTestFileBucket(t, nil, func(b *FileBucketOptionBuilder) { b.SetShardDepth(2) })
+	tmp := config.TempFileName("reports/2024.pdf")
+	if tmp != "f8/ec/reports/2024.pdf.tmp" {
+		t.Errorf("TempFileName() = %q, want f8/ec/reports/2024.pdf.tmp", tmp)
+	}
+	if got, want := path.Dir(tmp), path.Dir(config.ShardPath("reports/2024.pdf")); got != want {
+		t.Errorf("TempFileName() directory = %q, want the final file's directory %q", got, want)
+	}
+}
+
+func TestFileBucketConfigShardDepthValidation(t *testing.T) {
+	_, err := NewFileBucketConfig(NewFileBucketOption().SetBasePath(t.TempDir()).SetShardDepth(-1))
+	requireValidationError(t, err, "ShardDepth", RuleMin)
+	_, err = NewFileBucketConfig(NewFileBucketOption().SetBasePath(t.TempDir()).SetShardDepth(MaxFileBucketShardDepth + 1))
+	requireValidationError(t, err, "ShardDepth", RuleMax)
+}
+
+// cancelAfterContext is a context reporting itself canceled once Err has been called n times,
+// cancelling a walk at a deterministic point.
+type cancelAfterContext struct {
+	context.Context
+	n int
+}
+
+func (c *cancelAfterContext) Err() error {
+	if c.n <= 0 {
+		return context.Canceled
+	}
+	c.n--
+	return nil
+}
+
+func TestFileBucketConfigUsageContext(t *testing.T) {
+	files := map[string]string{}
+	for i := 0; i < 100; i++ {
+		files[fmt.Sprintf("dir%d/file%d.txt", i%10, i)] = "1234567890"
+	}
+	config := newTestFileBucket(t, files, func(b *FileBucketOptionBuilder) { b.SetMaxTotalSize(1 << 20) })
+	used, err := config.UsageContext(context.Background())
+	if err != nil || used != 1000 {
+		t.Fatalf("UsageContext() = %d, %v, want 1000", used, err)
+	}
+
+	ctx := &cancelAfterContext{Context: context.Background(), n: 20}
+	if used, err := config.UsageContext(ctx); !errors.Is(err, context.Canceled) || used != 0 {
+		t.Errorf("UsageContext() = %d, %v, want the walk to stop with context.Canceled", used, err)
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := config.CheckQuotaContext(canceled, 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("CheckQuotaContext() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestFileBucketConfigUsageContextSymlinks(t *testing.T) {
+	config := newTestFileBucket(t, map[string]string{"a.txt": "12345", "nested/b.txt": "123"})
+	outside := filepath.Join(t.TempDir(), "outside.txt")
+	if err := os.WriteFile(outside, []byte("1234567890"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for link, target := range map[string]string{
+		"nested/loop": config.BasePath,
+		"self":        ".",
+		"outside.txt": outside,
+	} {
+		if err := os.Symlink(target, filepath.Join(config.BasePath, filepath.FromSlash(link))); err != nil {
+			t.Skipf("symbolic links are not supported: %v", err)
+		}
+	}
+
+	done := make(chan struct{})
+	var used int64
+	var err error
+	go func() {
+		defer close(done)
+		used, err = config.UsageContext(context.Background())
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("UsageContext() did not return, want symbolic link cycles to be skipped")
+	}
+	if err != nil || used != 8 {
+		t.Errorf("UsageContext() = %d, %v, want 8 bytes without the linked files", used, err)
+	}
+}
@@ -0,0 +1,46 @@
+package alex
+
+import "sync"
+
+// BuildHook is called with every configuration successfully built by a constructor of this package
+// (NewRedisConfig, NewMinioConfig, NewFileBucketConfig, etc.), for instance to record metrics.
+// Its kind is available as the "kind" entry of config.Labels(). Hooks must be safe for concurrent use.
+type BuildHook func(config Config)
+
+var (
+	buildHooksMu sync.RWMutex // buildHooksMu guards buildHooks
+	buildHooks   []BuildHook  // buildHooks are the hooks registered with AddBuildHook
+)
+
+// AddBuildHook registers a hook called after each successful configuration build.
+// Hooks are called in registration order and cannot be removed.
+//
+// Parameters:
+//   - hook: The hook to register; nil is ignored
+//
+// Example:
+//
+//	AddBuildHook(func(config Config) {
+//	    log.Printf("built %s config", config.Labels()["kind"])
+//	})
+func AddBuildHook(hook BuildHook) {
+	if hook == nil {
+		return
+	}
+	buildHooksMu.Lock()
+	defer buildHooksMu.Unlock()
+	buildHooks = append(buildHooks, hook)
+}
+
+// notifyBuild calls every registered BuildHook with a configuration that was just built.
+//
+// Parameters:
+//   - config: The configuration that was built
+func notifyBuild(config Config) {
+	buildHooksMu.RLock()
+	hooks := buildHooks
+	buildHooksMu.RUnlock()
+	for _, hook := range hooks {
+		hook(config)
+	}
+}
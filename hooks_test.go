@@ -0,0 +1,31 @@
+package alex
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestAddBuildHook(t *testing.T) {
+	var mu sync.Mutex
+	var kinds []string
+	AddBuildHook(nil)
+	AddBuildHook(func(config Config) {
+		if _, ok := config.(*SpannerConfig); !ok {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		kinds = append(kinds, config.Labels()["kind"])
+	})
+	if _, err := NewSpannerConfig(NewSpannerOptions().SetProject("p").SetInstance("i").SetDatabase("d")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewSpannerConfig(NewSpannerOptions().SetProject("p")); err == nil {
+		t.Fatal("NewSpannerConfig() error = nil, want a validation error")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(kinds) != 1 || kinds[0] != "spanner" {
+		t.Errorf("hook saw %v, want one successful spanner build", kinds)
+	}
+}
@@ -0,0 +1,48 @@
+package alex
+
+import "sync"
+
+// Logger receives non-fatal warnings raised while constructing configurations,
+// such as a scheme that conflicts with the SSL setting. Implementations must be safe for concurrent use.
+type Logger interface {
+	// Warnf logs a warning message formatted according to a format specifier.
+	Warnf(format string, args ...any)
+}
+
+var (
+	loggerMu sync.RWMutex // loggerMu guards logger
+	logger   Logger       // logger is the package-level Logger set by SetLogger
+)
+
+// SetLogger sets the package-level Logger used by constructors to report non-fatal warnings.
+// Passing nil disables warnings, which is the default.
+//
+// Parameters:
+//   - l: The Logger that receives warnings, or nil to stay silent
+//
+// Example:
+//
+//	type stdLogger struct{}
+//
+//	func (stdLogger) Warnf(format string, args ...any) { log.Printf("WARN "+format, args...) }
+//
+//	SetLogger(stdLogger{})
+func SetLogger(l Logger) {
+	loggerMu.Lock()
+	defer loggerMu.Unlock()
+	logger = l
+}
+
+// warnf reports a warning through the package-level Logger, if one is set.
+//
+// Parameters:
+//   - format: The format specifier of the warning
+//   - args: The arguments referenced by the format specifier
+func warnf(format string, args ...any) {
+	loggerMu.RLock()
+	l := logger
+	loggerMu.RUnlock()
+	if l != nil {
+		l.Warnf(format, args...)
+	}
+}
@@ -0,0 +1,77 @@
+package alex
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recordingLogger is a Logger that records every warning it receives.
+type recordingLogger struct {
+	mu       sync.Mutex
+	warnings []string
+}
+
+func (l *recordingLogger) Warnf(format string, args ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.warnings = append(l.warnings, fmt.Sprintf(format, args...))
+}
+
+// setTestLogger installs a recordingLogger for the duration of the test.
+func setTestLogger(t *testing.T) *recordingLogger {
+	t.Helper()
+	l := &recordingLogger{}
+	SetLogger(l)
+	t.Cleanup(func() { SetLogger(nil) })
+	return l
+}
+
+func TestLoggerWarnsOnSoftIssues(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func() error
+		want  string
+	}{
+		{name: "redis_password_without_tls", build: func() error {
+			_, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("localhost:6379").SetPassword("secret"))
+			return err
+		}, want: "redis password for localhost:6379 is sent without TLS"},
+		{name: "minio_port_ssl_mismatch", build: func() error {
+			_, err := NewMinioConfig(newTestMinioOptions().SetEndpoint("localhost:443"))
+			return err
+		}, want: "443"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := setTestLogger(t)
+			if err := tt.build(); err != nil {
+				t.Fatalf("constructor error = %v, want a warning only", err)
+			}
+			if len(l.warnings) != 1 || !strings.Contains(l.warnings[0], tt.want) {
+				t.Errorf("warnings = %q, want one containing %q", l.warnings, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoggerSilentWithoutIssues(t *testing.T) {
+	l := setTestLogger(t)
+	if _, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("localhost:6379")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewMinioConfig(newTestMinioOptions()); err != nil {
+		t.Fatal(err)
+	}
+	if len(l.warnings) != 0 {
+		t.Errorf("warnings = %q, want none", l.warnings)
+	}
+}
+
+func TestLoggerUnset(t *testing.T) {
+	SetLogger(nil)
+	if _, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("localhost:6379").SetPassword("secret")); err != nil {
+		t.Fatalf("NewRedisConfig() without a logger error = %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+package alex
+
+import (
+	"log/slog"
+	"strings"
+
+	"github.com/zeroxsolutions/strike/builderutil"
+)
+
+// NewMemcachedConfig creates a new MemcachedConfig from MemcachedOptions by applying the provided option functions.
+// It uses the builderutil package to build the configuration options in a functional options pattern,
+// then creates and returns a final MemcachedConfig instance.
+//
+// Validation rules:
+//   - At least one server is required, and each server must be in "host:port" form
+//   - Timeout must be greater than or equal to 0
+//   - Max idle connections must be greater than or equal to 0
+//
+// Parameters:
+//   - opts: Variable number of option functions that configure the MemcachedOptions
+//
+// Returns:
+//   - *MemcachedConfig: A pointer to the final Memcached configuration instance
+//   - error: An error if the configuration building process fails or validation fails
+//
+// Example:
+//
+//	builder := NewMemcachedOptions()
+//	config, err := NewMemcachedConfig(builder.AddServer("localhost:11211").SetTimeout(500 * time.Millisecond))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewMemcachedConfig(opts ...builderutil.Lister[MemcachedOptions]) (*MemcachedConfig, error) {
+	options, err := builderutil.Build(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if options.TrimSpace {
+		options.Servers = trimSpaceAll(options.Servers)
+	}
+	config := &MemcachedConfig{
+		Servers:      options.Servers,
+		Timeout:      options.Timeout,
+		MaxIdleConns: options.MaxIdleConns,
+		Extra:        options.Extra,
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	notifyBuild(config)
+	return config, nil
+}
+
+// Validate checks that the MemcachedConfig satisfies the rules enforced by its constructor.
+// It performs no I/O, so it can be used to re-check a configuration at any time.
+//
+// Returns:
+//   - error: A *ValidationError describing the first rule the configuration violates, or nil if it is valid
+func (c *MemcachedConfig) Validate() error {
+	if err := validateHostPorts(c.Servers, "Servers", "memcached server"); err != nil {
+		return err
+	}
+	if c.Timeout < 0 {
+		return newValidationError("Timeout", RuleMin, "memcached timeout must be greater than or equal to 0")
+	}
+	if c.MaxIdleConns < 0 {
+		return newValidationError("MaxIdleConns", RuleMin, "memcached max idle connections must be greater than or equal to 0")
+	}
+	if err := validateExtra(c.Extra, "memcached"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Labels returns identifying, non-secret key/value pairs describing the configuration.
+//
+// Returns:
+//   - map[string]string: The "kind" and "servers" labels, the servers being comma-separated
+func (c *MemcachedConfig) Labels() map[string]string {
+	return map[string]string{
+		"kind":    "memcached",
+		"servers": strings.Join(c.Servers, ","),
+	}
+}
+
+// LogValue implements slog.LogValuer, so that logging the configuration with log/slog
+// (e.g., slog.Info("config", "memcached", cfg)) emits a group of its main fields.
+// Memcached holds no secrets, so nothing is redacted. Extra flags are not logged.
+//
+// Returns:
+//   - slog.Value: A group value holding the logged fields
+func (c *MemcachedConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("servers", strings.Join(c.Servers, ",")),
+		slog.Duration("timeout", c.Timeout),
+		slog.Int("max_idle_conns", c.MaxIdleConns),
+	)
+}
@@ -0,0 +1,167 @@
+package alex
+
+import (
+	"slices"
+	"time"
+)
+
+// MemcachedOptions represents the configuration options for a Memcached client.
+// It includes the server addresses, the network timeout, and the idle connection limit.
+type MemcachedOptions struct {
+	Servers      []string          // Servers are the "host:port" addresses of the Memcached servers.
+	Timeout      time.Duration     // Timeout is the socket read/write timeout (0 uses the client default).
+	MaxIdleConns int               // MaxIdleConns is the maximum number of idle connections kept per server (0 uses the client default).
+	Extra        map[string]string // Extra holds backend-specific flags without a dedicated field yet; unknown keys are preserved.
+	TrimSpace    bool              // TrimSpace is a flag indicating whether the constructor trims leading and trailing white space from the string fields.
+}
+
+// MemcachedOptionsBuilder provides a builder pattern for constructing MemcachedOptions.
+// It accumulates option functions that can be applied to configure a MemcachedOptions instance.
+// This builder implements the builderutil.Lister interface to work with the functional options pattern.
+type MemcachedOptionsBuilder struct {
+	Opts []func(*MemcachedOptions) error // Opts contains the list of option functions to be applied
+}
+
+// SetExtra sets a backend-specific flag that has no dedicated field yet, as a forward-compatible escape hatch.
+// It appends an option function that adds the key/value pair to the Extra field of MemcachedOptions.
+// The constructor does not interpret the flags but preserves them in the final configuration.
+//
+// Parameters:
+//   - key: The flag name (must not be empty); setting an existing key replaces its value
+//   - value: The flag value
+//
+// Returns:
+//   - *MemcachedOptionsBuilder: The builder instance for method chaining
+func (builder *MemcachedOptionsBuilder) SetExtra(key, value string) *MemcachedOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *MemcachedOptions) error {
+		if args.Extra == nil {
+			args.Extra = make(map[string]string)
+		}
+		args.Extra[key] = value
+		return nil
+	})
+	return builder
+}
+
+// List returns the slice of option functions accumulated by the builder.
+// This method implements the builderutil.Lister interface, allowing the builder
+// to be used with the builderutil.Build function.
+// The functions are returned in the order the setters were called and builderutil.Build applies them
+// in that order, so when several setters touch the same field the last call wins. The returned slice
+// is a copy, so callers cannot reorder or drop the queued options.
+//
+// Returns:
+//   - []func(*MemcachedOptions) error: A slice of option functions that can be applied to configure MemcachedOptions
+func (builder *MemcachedOptionsBuilder) List() []func(*MemcachedOptions) error {
+	return slices.Clone(builder.Opts)
+}
+
+// NewMemcachedOptions creates and returns a new instance of MemcachedOptionsBuilder.
+// This function provides a convenient way to initialize the builder for creating Memcached configuration options.
+//
+// Returns:
+//   - *MemcachedOptionsBuilder: A new instance of MemcachedOptionsBuilder ready to be configured
+//
+// Example:
+//
+//	builder := NewMemcachedOptions()
+//	config, err := NewMemcachedConfig(builder.AddServer("cache-1:11211").AddServer("cache-2:11211"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Memcached Config: %+v\n", config)
+func NewMemcachedOptions() *MemcachedOptionsBuilder {
+	return &MemcachedOptionsBuilder{}
+}
+
+// SetServers configures the Memcached server addresses, replacing any previously added server.
+// It appends an option function that sets the Servers field of MemcachedOptions.
+//
+// Parameters:
+//   - servers: The "host:port" addresses of the servers (e.g., "localhost:11211")
+//
+// Returns:
+//   - *MemcachedOptionsBuilder: The builder instance for method chaining
+func (builder *MemcachedOptionsBuilder) SetServers(servers ...string) *MemcachedOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *MemcachedOptions) error {
+		args.Servers = slices.Clone(servers)
+		return nil
+	})
+	return builder
+}
+
+// AddServer adds a Memcached server address.
+// It appends an option function that appends the address to the Servers field of MemcachedOptions.
+//
+// Parameters:
+//   - server: The "host:port" address of the server (e.g., "localhost:11211")
+//
+// Returns:
+//   - *MemcachedOptionsBuilder: The builder instance for method chaining
+func (builder *MemcachedOptionsBuilder) AddServer(server string) *MemcachedOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *MemcachedOptions) error {
+		args.Servers = append(args.Servers, server)
+		return nil
+	})
+	return builder
+}
+
+// SetTimeout configures the socket read/write timeout.
+// It appends an option function that sets the Timeout field of MemcachedOptions.
+//
+// Parameters:
+//   - timeout: The socket timeout (0 uses the client default)
+//
+// Returns:
+//   - *MemcachedOptionsBuilder: The builder instance for method chaining
+func (builder *MemcachedOptionsBuilder) SetTimeout(timeout time.Duration) *MemcachedOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *MemcachedOptions) error {
+		args.Timeout = timeout
+		return nil
+	})
+	return builder
+}
+
+// SetMaxIdleConns configures the maximum number of idle connections kept per server.
+// It appends an option function that sets the MaxIdleConns field of MemcachedOptions.
+//
+// Parameters:
+//   - n: The maximum number of idle connections (0 uses the client default)
+//
+// Returns:
+//   - *MemcachedOptionsBuilder: The builder instance for method chaining
+func (builder *MemcachedOptionsBuilder) SetMaxIdleConns(n int) *MemcachedOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *MemcachedOptions) error {
+		args.MaxIdleConns = n
+		return nil
+	})
+	return builder
+}
+
+// SetTrimSpace configures whether leading and trailing white space is trimmed from the string fields before validation.
+// It appends an option function that sets the TrimSpace field of MemcachedOptions.
+// Trimming is off by default so that values are used exactly as given; enabling it guards against
+// a server list split on ", " that leaves leading spaces silently breaking the configuration.
+//
+// Parameters:
+//   - trim: A flag indicating whether the string fields are trimmed
+//
+// Returns:
+//   - *MemcachedOptionsBuilder: The builder instance for method chaining
+func (builder *MemcachedOptionsBuilder) SetTrimSpace(trim bool) *MemcachedOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *MemcachedOptions) error {
+		args.TrimSpace = trim
+		return nil
+	})
+	return builder
+}
+
+// MemcachedConfig represents the final Memcached configuration used for establishing connections.
+// This struct is created from MemcachedOptions after validation and contains all the necessary
+// parameters for connecting to a pool of Memcached servers.
+type MemcachedConfig struct {
+	Servers      []string          // Servers are the "host:port" addresses of the Memcached servers.
+	Timeout      time.Duration     // Timeout is the socket read/write timeout (0 uses the client default).
+	MaxIdleConns int               // MaxIdleConns is the maximum number of idle connections kept per server (0 uses the client default).
+	Extra        map[string]string // Extra holds backend-specific flags without a dedicated field yet; unknown keys are preserved.
+}
@@ -0,0 +1,52 @@
+package alex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewMemcachedConfig(t *testing.T) {
+	config, err := NewMemcachedConfig(NewMemcachedOptions().
+		AddServer("cache1:11211").
+		AddServer("cache2:11211").
+		SetTimeout(500 * time.Millisecond).
+		SetMaxIdleConns(4))
+	if err != nil {
+		t.Fatalf("NewMemcachedConfig() error = %v", err)
+	}
+	if len(config.Servers) != 2 || config.Servers[0] != "cache1:11211" || config.Servers[1] != "cache2:11211" {
+		t.Errorf("Servers = %v, want [cache1:11211 cache2:11211]", config.Servers)
+	}
+	if config.Timeout != 500*time.Millisecond || config.MaxIdleConns != 4 {
+		t.Errorf("Timeout, MaxIdleConns = %v, %d, want 500ms, 4", config.Timeout, config.MaxIdleConns)
+	}
+
+	config, err = NewMemcachedConfig(NewMemcachedOptions().AddServer("old:11211").SetServers("cache1:11211"))
+	if err != nil {
+		t.Fatalf("NewMemcachedConfig() error = %v", err)
+	}
+	if len(config.Servers) != 1 || config.Servers[0] != "cache1:11211" {
+		t.Errorf("Servers = %v, want SetServers to replace the added server", config.Servers)
+	}
+}
+
+func TestNewMemcachedConfigValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *MemcachedOptionsBuilder
+		field   string
+		rule    string
+	}{
+		{name: "no_servers", builder: NewMemcachedOptions(), field: "Servers", rule: RuleRequired},
+		{name: "empty_servers", builder: NewMemcachedOptions().SetServers(), field: "Servers", rule: RuleRequired},
+		{name: "invalid_server", builder: NewMemcachedOptions().SetServers("cache1:11211", "cache2"), field: "Servers[1]", rule: RuleFormat},
+		{name: "negative_timeout", builder: NewMemcachedOptions().AddServer("cache:11211").SetTimeout(-time.Second), field: "Timeout", rule: RuleMin},
+		{name: "negative_max_idle_conns", builder: NewMemcachedOptions().AddServer("cache:11211").SetMaxIdleConns(-1), field: "MaxIdleConns", rule: RuleMin},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMemcachedConfig(tt.builder)
+			requireValidationError(t, err, tt.field, tt.rule)
+		})
+	}
+}
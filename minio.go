@@ -1,14 +1,45 @@
 package alex
 
 import (
-	"errors"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/minio/minio-go/v7"
 	"github.com/zeroxsolutions/strike/builderutil"
 )
 
+// Default minimum key lengths enforced by NewMinioConfig unless overridden with SetMinKeyLength.
+const (
+	MinioMinAccessKeyLength = 3 // MinioMinAccessKeyLength is the default minimum length of the access key.
+	MinioMinSecretKeyLength = 8 // MinioMinSecretKeyLength is the default minimum length of the secret key.
+)
+
+// S3 limits on object tags enforced for DefaultObjectTags.
+const (
+	MinioMaxTagKeyLength   = 128 // MinioMaxTagKeyLength is the maximum length of an object tag key, in characters.
+	MinioMaxTagValueLength = 256 // MinioMaxTagValueLength is the maximum length of an object tag value, in characters.
+)
+
 // NewMinioConfig creates a new MinioConfig from MinioOption by applying the provided option functions.
 // It uses the builderutil package to build the configuration options in a functional options pattern,
 // then creates and returns a final MinioConfig instance.
+// When the backend is disabled via SetEnabled(false), validation is skipped and an empty
+// MinioConfig with Enabled set to false is returned.
+// The endpoint is normalized: trailing slashes are removed and, when no port is given, port 443 is
+// used with UseSSL and port 80 otherwise (e.g., "minio.example.com/" becomes "minio.example.com:443").
+// Either a single Endpoint or a non-empty list of failover Endpoints (AddEndpoint) must be set;
+// each entry of the list is normalized and checked like Endpoint.
+// A key and its key file (AccessKeyFile, SecretKeyFile) cannot both be set; key files are read at build time.
+// When StrictScheme is disabled, an endpoint scheme or port that conflicts with UseSSL is
+// reported as a warning through the package Logger instead of failing.
 //
 // Parameters:
 //   - opts: Variable number of option functions that configure the MinioOption
@@ -26,28 +57,582 @@ import (
 //	}
 //	fmt.Printf("Minio Config: %+v\n", config)
 func NewMinioConfig(opts ...builderutil.Lister[MinioOption]) (*MinioConfig, error) {
+	return newMinioConfig(context.Background(), opts)
+}
+
+// newMinioConfig implements NewMinioConfig and BuildContext.
+// The context is passed to the SecretResolver and checked before the key files are read; once it is done,
+// the build stops with the context error and build hooks are not notified.
+//
+// Parameters:
+//   - ctx: The context bounding the secret resolution and file reads
+//   - opts: The option functions that configure the MinioOption
+//
+// Returns:
+//   - *MinioConfig: A pointer to the final Minio configuration instance
+//   - error: An error if ctx is done, or if the configuration building process or validation fails
+func newMinioConfig(ctx context.Context, opts []builderutil.Lister[MinioOption]) (*MinioConfig, error) {
 	options, err := builderutil.Build(opts...)
 	if err != nil {
 		return nil, err
 	}
-	if options.Endpoint == "" {
-		return nil, errors.New("minio endpoint is required")
+	if options.Enabled != nil && !*options.Enabled {
+		return &MinioConfig{Enabled: false}, nil
+	}
+	if options.TrimSpace {
+		trimSpace(&options.Endpoint, &options.AccessKey, &options.SecretKey, &options.BucketName, &options.Region,
+			&options.BucketPolicy, &options.KeyPrefix, &options.UserAgent, &options.ChecksumAlgorithm, &options.AccessKeyFile,
+			&options.SecretKeyFile, &options.STSEndpoint, &options.RoleARN, &options.SSEType, &options.KMSKeyID)
+		options.Endpoints = trimSpaceAll(options.Endpoints)
+	}
+	if options.AccessKey, err = resolveSecret(ctx, options.AccessKey); err != nil {
+		return nil, err
+	}
+	if options.SecretKey, err = resolveSecret(ctx, options.SecretKey); err != nil {
+		return nil, err
+	}
+	if options.AccessKeyFile != "" {
+		if options.AccessKey != "" {
+			return nil, newValidationError("AccessKeyFile", RuleConflict, "minio access key and access key file cannot both be set")
+		}
+		if options.AccessKey, err = readSecretFile(ctx, options.AccessKeyFile); err != nil {
+			return nil, fmt.Errorf("read minio access key file: %w", err)
+		}
+	}
+	if options.SecretKeyFile != "" {
+		if options.SecretKey != "" {
+			return nil, newValidationError("SecretKeyFile", RuleConflict, "minio secret key and secret key file cannot both be set")
+		}
+		if options.SecretKey, err = readSecretFile(ctx, options.SecretKeyFile); err != nil {
+			return nil, fmt.Errorf("read minio secret key file: %w", err)
+		}
+	}
+	config := &MinioConfig{
+		Endpoint:              normalizeMinioEndpoint(options.Endpoint, options.UseSSL),
+		AccessKey:             options.AccessKey,
+		SecretKey:             options.SecretKey,
+		UseSSL:                options.UseSSL,
+		BucketName:            options.BucketName,
+		Region:                options.Region,
+		StrictScheme:          options.StrictScheme,
+		AutoDetectRegion:      options.AutoDetectRegion,
+		RequireRegion:         options.RequireRegion,
+		MaxPresignExpiry:      options.MaxPresignExpiry,
+		TLSConfig:             options.TLSConfig,
+		TLSOptions:            options.TLSOptions,
+		BucketPolicy:          options.BucketPolicy,
+		ExpireAfterDays:       options.ExpireAfterDays,
+		Enabled:               true,
+		KeyPrefix:             options.KeyPrefix,
+		PathStyle:             options.PathStyle,
+		RetryPolicy:           options.RetryPolicy,
+		FollowRegionRedirects: options.FollowRegionRedirects,
+		MaxRetries:            options.MaxRetries,
+		MinKeyLength:          options.MinKeyLength,
+		UserAgent:             options.UserAgent,
+		DNSResolver:           options.DNSResolver,
+		TraceWriter:           options.TraceWriter,
+		DefaultObjectTags:     options.DefaultObjectTags,
+		ChecksumAlgorithm:     options.ChecksumAlgorithm,
+		Extra:                 options.Extra,
+		STSEndpoint:           options.STSEndpoint,
+		RoleARN:               options.RoleARN,
+		SSEType:               options.SSEType,
+		KMSKeyID:              options.KMSKeyID,
+		CustomerKey:           options.CustomerKey,
+		BucketOptional:        options.BucketOptional,
+		Versioning:            options.Versioning,
+		ObjectLock:            options.ObjectLock,
+	}
+	for _, endpoint := range options.Endpoints {
+		config.Endpoints = append(config.Endpoints, normalizeMinioEndpoint(endpoint, options.UseSSL))
+	}
+	if config.MaxAttempts == 0 && config.MaxRetries == 0 {
+		config.MaxAttempts = minio.MaxRetry
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if !config.StrictScheme {
+		for _, endpoint := range config.AllEndpoints() {
+			if err := checkMinioScheme(endpoint, config.UseSSL); err != nil {
+				warnf("%v", err)
+			}
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	notifyBuild(config)
+	return config, nil
+}
+
+// BuildContext builds the Minio configuration like NewMinioConfig, passing ctx to the SecretResolver
+// and checking it before the key files are read. When ctx is done, the build returns the context
+// error without notifying build hooks. Functions supplied with SetTLSConfigFunc run synchronously and are not interrupted.
+//
+// Parameters:
+//   - ctx: The context bounding the construction
+//
+// Returns:
+//   - *MinioConfig: A pointer to the final Minio configuration instance
+//   - error: An error if ctx is done before the build completes, or if building or validation fails
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	config, err := NewMinioOption().SetEndpoint("minio.example.com").SetAccessKey("accessKey").SetSecretKey("secretKey").SetBucketName("bucketName").BuildContext(ctx)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (builder *MinioOptionBuilder) BuildContext(ctx context.Context) (*MinioConfig, error) {
+	return newMinioConfig(ctx, []builderutil.Lister[MinioOption]{builder})
+}
+
+// RequiredMinioFields returns the names of the MinioConfig fields that NewMinioConfig requires to be set,
+// matching the Field of the RuleRequired validation errors reported when they are empty.
+// BucketName is listed by default, even though SetBucketOptional relaxes its requirement.
+// A new slice is returned on every call, so callers may modify it.
+//
+// Returns:
+//   - []string: The required field names, in the order they are validated
+func RequiredMinioFields() []string {
+	return []string{"Endpoint", "AccessKey", "SecretKey", "BucketName"}
+}
+
+// Validate checks that the MinioConfig satisfies the rules enforced by its constructor.
+// It performs no I/O, so it can be used to re-check a configuration at any time.
+//
+// Returns:
+//   - error: A *ValidationError describing the first rule the configuration violates, or nil if it is valid
+func (c *MinioConfig) Validate() error {
+	switch {
+	case c.Endpoint != "" && len(c.Endpoints) > 0:
+		return newValidationError("Endpoints", RuleConflict, "minio endpoint and endpoints cannot both be set")
+	case c.Endpoint == "" && len(c.Endpoints) == 0:
+		return newValidationError("Endpoint", RuleRequired, "minio endpoint is required")
+	}
+	for i, endpoint := range c.Endpoints {
+		if endpoint == "" {
+			return newValidationError(fmt.Sprintf("Endpoints[%d]", i), RuleRequired, fmt.Sprintf("minio endpoint[%d] is required", i))
+		}
+	}
+	if c.AccessKey == "" {
+		return newValidationError("AccessKey", RuleRequired, "minio access key is required")
+	}
+	if c.SecretKey == "" {
+		return newValidationError("SecretKey", RuleRequired, "minio secret key is required")
+	}
+	if c.MinKeyLength < 0 {
+		return newValidationError("MinKeyLength", RuleMin, "minio min key length must be greater than or equal to 0")
+	}
+	minAccessKeyLength, minSecretKeyLength := MinioMinAccessKeyLength, MinioMinSecretKeyLength
+	if c.MinKeyLength > 0 {
+		minAccessKeyLength, minSecretKeyLength = c.MinKeyLength, c.MinKeyLength
+	}
+	if len(c.AccessKey) < minAccessKeyLength {
+		return newValidationError("AccessKey", RuleMin, fmt.Sprintf("minio access key must be at least %d characters", minAccessKeyLength))
+	}
+	if len(c.SecretKey) < minSecretKeyLength {
+		return newValidationError("SecretKey", RuleMin, fmt.Sprintf("minio secret key must be at least %d characters", minSecretKeyLength))
+	}
+	if c.BucketName == "" && !c.BucketOptional {
+		return newValidationError("BucketName", RuleRequired, "minio bucket name is required")
+	}
+	if c.Region != "" && c.AutoDetectRegion {
+		return newValidationError("Region", RuleConflict, "minio region cannot be set when auto-detect region is enabled")
+	}
+	if c.Region == "" && c.RequireRegion && !c.AutoDetectRegion {
+		return newValidationError("Region", RuleRequired, "minio region is required unless auto-detect region is enabled")
+	}
+	if c.MaxPresignExpiry < 0 {
+		return newValidationError("MaxPresignExpiry", RuleMin, "minio max presign expiry must be greater than or equal to 0")
+	}
+	if c.BucketPolicy != "" && !json.Valid([]byte(c.BucketPolicy)) {
+		return newValidationError("BucketPolicy", RuleFormat, "minio bucket policy must be valid JSON")
+	}
+	if c.ExpireAfterDays < 0 {
+		return newValidationError("ExpireAfterDays", RuleMin, "minio expire after days must be greater than or equal to 0")
+	}
+	if err := c.RetryPolicy.Validate(); err != nil {
+		return nestValidationError(err, "RetryPolicy", "minio")
+	}
+	if c.TLSOptions != nil {
+		if err := c.TLSOptions.Validate(); err != nil {
+			return nestValidationError(err, "TLSOptions", "minio")
+		}
+	}
+	if c.MaxRetries < 0 {
+		return newValidationError("MaxRetries", RuleMin, "minio max retries must be greater than or equal to 0")
+	}
+	for key, value := range c.DefaultObjectTags {
+		switch {
+		case key == "":
+			return newValidationError("DefaultObjectTags", RuleRequired, "minio object tag key is required")
+		case utf8.RuneCountInString(key) > MinioMaxTagKeyLength:
+			return newValidationError("DefaultObjectTags", RuleMax, fmt.Sprintf("minio object tag key %q must be at most %d characters", key, MinioMaxTagKeyLength))
+		case value == "":
+			return newValidationError("DefaultObjectTags", RuleRequired, fmt.Sprintf("minio object tag %q value is required", key))
+		case utf8.RuneCountInString(value) > MinioMaxTagValueLength:
+			return newValidationError("DefaultObjectTags", RuleMax, fmt.Sprintf("minio object tag %q value must be at most %d characters", key, MinioMaxTagValueLength))
+		}
+	}
+	switch c.ChecksumAlgorithm {
+	case "", "CRC32", "CRC32C", "SHA1", "SHA256":
+	default:
+		return newValidationError("ChecksumAlgorithm", RuleFormat, fmt.Sprintf("minio checksum algorithm %q must be CRC32, CRC32C, SHA1, or SHA256", c.ChecksumAlgorithm))
+	}
+	if err := requireIf(c.RoleARN != "", c.STSEndpoint, "STSEndpoint", "minio sts endpoint is required when a role ARN is set"); err != nil {
+		return err
+	}
+	if err := requireIf(c.SSEType == "SSE-KMS", c.KMSKeyID, "KMSKeyID", "minio kms key id is required when sse type is SSE-KMS"); err != nil {
+		return err
+	}
+	switch c.SSEType {
+	case "", "SSE-S3", "SSE-KMS":
+	case "SSE-C":
+		if len(c.CustomerKey) != 32 {
+			return newValidationError("CustomerKey", RuleFormat, "minio customer key must be 32 bytes when sse type is SSE-C")
+		}
+	default:
+		return newValidationError("SSEType", RuleFormat, fmt.Sprintf("minio sse type %q must be SSE-S3, SSE-KMS, or SSE-C", c.SSEType))
+	}
+	if c.ObjectLock && !c.Versioning {
+		return newValidationError("Versioning", RuleRequired, "minio versioning is required when object lock is enabled")
+	}
+	if c.StrictScheme {
+		for _, endpoint := range c.AllEndpoints() {
+			if err := checkMinioScheme(endpoint, c.UseSSL); err != nil {
+				return err
+			}
+		}
+	}
+	if err := validateExtra(c.Extra, "minio"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsPubliclyReadable reports whether BucketPolicy lets anonymous clients read the objects under KeyPrefix,
+// i.e. it has an unconditional "Allow" statement granting "s3:GetObject" (or a wildcard action) to the "*"
+// principal on a resource covering those objects, and no statement denying it.
+// A configuration without a bucket policy is private.
+//
+// Returns:
+//   - bool: true if the objects of the configuration can be read without credentials
+func (c *MinioConfig) IsPubliclyReadable() bool {
+	var policy struct {
+		Statement minioPolicyStatements
+	}
+	if c.BucketPolicy == "" || json.Unmarshal([]byte(c.BucketPolicy), &policy) != nil {
+		return false
+	}
+	objects := "arn:aws:s3:::" + c.BucketName + "/" + strings.TrimPrefix(c.ObjectKey(""), "/")
+	allowed := false
+	for _, statement := range policy.Statement {
+		if len(statement.Condition) > 0 || !statement.grantsAnonymousRead(objects) {
+			continue
+		}
+		switch statement.Effect {
+		case "Deny":
+			return false
+		case "Allow":
+			allowed = true
+		}
+	}
+	return allowed
+}
+
+// minioPolicyStatement is the subset of a bucket policy statement inspected by IsPubliclyReadable.
+type minioPolicyStatement struct {
+	Effect    string
+	Principal json.RawMessage
+	Action    minioPolicyStrings
+	Resource  minioPolicyStrings
+	Condition map[string]json.RawMessage
+}
+
+// grantsAnonymousRead reports whether the statement applies GetObject to the "*" principal on objects,
+// the ARN prefix of the objects under KeyPrefix.
+func (s minioPolicyStatement) grantsAnonymousRead(objects string) bool {
+	var principal struct{ AWS minioPolicyStrings }
+	var wildcard string
+	if json.Unmarshal(s.Principal, &wildcard) == nil {
+		principal.AWS = minioPolicyStrings{wildcard}
+	} else if json.Unmarshal(s.Principal, &principal) != nil {
+		return false
+	}
+	if !slices.Contains(principal.AWS, "*") {
+		return false
+	}
+	if !slices.ContainsFunc(s.Action, func(action string) bool {
+		return action == "*" || action == "s3:*" || action == "s3:GetObject"
+	}) {
+		return false
+	}
+	return slices.ContainsFunc(s.Resource, func(resource string) bool {
+		return resource == "*" || (strings.HasSuffix(resource, "*") && strings.HasPrefix(objects, strings.TrimSuffix(resource, "*")))
+	})
+}
+
+// minioPolicyStatements decodes the Statement of a bucket policy, which is either a single statement or a list.
+type minioPolicyStatements []minioPolicyStatement
+
+// UnmarshalJSON decodes a single statement or a list of statements.
+func (s *minioPolicyStatements) UnmarshalJSON(data []byte) error {
+	var statement minioPolicyStatement
+	if err := json.Unmarshal(data, &statement); err == nil {
+		*s = minioPolicyStatements{statement}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]minioPolicyStatement)(s))
+}
+
+// minioPolicyStrings decodes a bucket policy element that is either a single string or a list of strings.
+type minioPolicyStrings []string
+
+// UnmarshalJSON decodes a single string or a list of strings.
+func (s *minioPolicyStrings) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		*s = minioPolicyStrings{value}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(s))
+}
+
+// PrimaryEndpoint returns the endpoint the client connects to: Endpoint, or the first of Endpoints.
+//
+// Returns:
+//   - string: The primary endpoint, or an empty string if none is configured
+func (c *MinioConfig) PrimaryEndpoint() string {
+	if c.Endpoint != "" || len(c.Endpoints) == 0 {
+		return c.Endpoint
+	}
+	return c.Endpoints[0]
+}
+
+// AllEndpoints returns every configured endpoint, the primary one first, for failover.
+// A new slice is returned on every call, so callers may modify it.
+//
+// Returns:
+//   - []string: Endpoint alone, or a copy of Endpoints
+func (c *MinioConfig) AllEndpoints() []string {
+	if c.Endpoint != "" {
+		return []string{c.Endpoint}
+	}
+	return slices.Clone(c.Endpoints)
+}
+
+// normalizeMinioEndpoint removes the trailing slashes of endpoint and, when it has no port,
+// appends the default port of the connection: 443 when useSSL is set, 80 otherwise.
+// A scheme prefix is kept; an endpoint with a path is only stripped of its trailing slashes.
+//
+// Parameters:
+//   - endpoint: The Minio endpoint, optionally prefixed with "http://" or "https://"
+//   - useSSL: A flag indicating whether SSL is enabled for the connection
+//
+// Returns:
+//   - string: The normalized endpoint, or an empty string if endpoint is empty
+func normalizeMinioEndpoint(endpoint string, useSSL bool) string {
+	endpoint = strings.TrimRight(endpoint, "/")
+	scheme, host := "", endpoint
+	if before, rest, ok := strings.Cut(endpoint, "://"); ok {
+		scheme, host = before+"://", rest
+	}
+	if host == "" || strings.Contains(host, "/") {
+		return endpoint
+	}
+	if _, _, err := net.SplitHostPort(host); err == nil {
+		return endpoint
+	}
+	port := "80"
+	if useSSL {
+		port = "443"
+	}
+	return scheme + net.JoinHostPort(strings.Trim(host, "[]"), port)
+}
+
+// checkMinioScheme reports an error when the scheme or port of the endpoint conflicts with the SSL setting.
+// An endpoint without a scheme or port is accepted, as there is nothing to compare against.
+//
+// Parameters:
+//   - endpoint: The Minio endpoint, optionally prefixed with "http://" or "https://"
+//   - useSSL: A flag indicating whether SSL is enabled for the connection
+//
+// Returns:
+//   - error: An error if the endpoint looks like plaintext while SSL is enabled, or like TLS while it is disabled
+func checkMinioScheme(endpoint string, useSSL bool) error {
+	host := endpoint
+	if scheme, rest, ok := strings.Cut(endpoint, "://"); ok {
+		switch {
+		case useSSL && strings.EqualFold(scheme, "http"):
+			return newValidationError("Endpoint", RuleConflict, "minio endpoint scheme http conflicts with use SSL enabled")
+		case !useSSL && strings.EqualFold(scheme, "https"):
+			return newValidationError("Endpoint", RuleConflict, "minio endpoint scheme https conflicts with use SSL disabled")
+		}
+		host = rest
+	}
+	host, _, _ = strings.Cut(host, "/")
+	_, port, err := net.SplitHostPort(host)
+	if err != nil {
+		return nil
+	}
+	switch {
+	case useSSL && (port == "80" || port == "9000"):
+		return newValidationError("Endpoint", RuleConflict, fmt.Sprintf("minio endpoint port %s conflicts with use SSL enabled", port))
+	case !useSSL && (port == "443" || port == "9443"):
+		return newValidationError("Endpoint", RuleConflict, fmt.Sprintf("minio endpoint port %s conflicts with use SSL disabled", port))
+	}
+	return nil
+}
+
+// EqualIgnoringSecrets reports whether c and other are equal in every field except SecretKey and CustomerKey.
+// It lets callers detect structural configuration changes separately from credential rotation.
+// TLSConfig is compared by presence only, as in CmpOptions, since its callbacks cannot be compared meaningfully.
+//
+// Parameters:
+//   - other: The configuration to compare against
+//
+// Returns:
+//   - bool: true if both configurations are nil, or equal once their secrets are ignored
+func (c *MinioConfig) EqualIgnoringSecrets(other *MinioConfig) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	a, b := *c, *other
+	a.SecretKey, b.SecretKey = "", ""
+	a.CustomerKey, b.CustomerKey = nil, nil
+	if (a.TLSConfig == nil) != (b.TLSConfig == nil) {
+		return false
+	}
+	a.TLSConfig, b.TLSConfig = nil, nil
+	return reflect.DeepEqual(a, b)
+}
+
+// OverlaySecrets returns a copy of base whose credentials are replaced by the non-empty
+// AccessKey and SecretKey of secrets, so that secrets loaded from a separate source win over
+// any values present in the base configuration. Every other field is taken from base.
+// Neither argument is modified.
+//
+// Parameters:
+//   - base: The configuration providing every non-secret field
+//   - secrets: The configuration providing the credentials; nil leaves the base credentials in place
+//
+// Returns:
+//   - *MinioConfig: The overlaid copy, or nil if base is nil
+//
+// Example:
+//
+//	config := OverlaySecrets(fileConfig, vaultConfig)
+func OverlaySecrets(base *MinioConfig, secrets *MinioConfig) *MinioConfig {
+	if base == nil {
+		return nil
+	}
+	merged := *base
+	if secrets == nil {
+		return &merged
+	}
+	if secrets.AccessKey != "" {
+		merged.AccessKey = secrets.AccessKey
+	}
+	if secrets.SecretKey != "" {
+		merged.SecretKey = secrets.SecretKey
+	}
+	return &merged
+}
+
+// Labels returns identifying, non-secret key/value pairs describing the configuration.
+//
+// Returns:
+//   - map[string]string: The "kind", "endpoint", "bucket", and "region" labels
+func (c *MinioConfig) Labels() map[string]string {
+	return map[string]string{
+		"kind":     "minio",
+		"endpoint": c.PrimaryEndpoint(),
+		"bucket":   c.BucketName,
+		"region":   c.Region,
+	}
+}
+
+// LogValue implements slog.LogValuer, so that logging the configuration with log/slog
+// (e.g., slog.Info("config", "minio", cfg)) emits a group of its main fields.
+// The access key, secret key, and SSE-C customer key are redacted. Extra flags are not logged.
+//
+// Returns:
+//   - slog.Value: A group value holding the logged fields
+func (c *MinioConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("endpoints", strings.Join(c.AllEndpoints(), ",")),
+		secretAttr("access_key", c.AccessKey),
+		secretAttr("secret_key", c.SecretKey),
+		slog.Bool("use_ssl", c.UseSSL),
+		slog.String("bucket", c.BucketName),
+		slog.String("region", c.Region),
+		slog.String("key_prefix", c.KeyPrefix),
+		slog.String("sse_type", c.SSEType),
+		secretAttr("customer_key", string(c.CustomerKey)),
+		slog.Bool("enabled", c.Enabled),
+	)
+}
+
+// ProvisioningPlan describes, one line per action, the provisioning implied by the configuration,
+// so that it can be reviewed or dry-run before touching the server. It is derived purely from the
+// configuration and performs no I/O. The lines are returned in the order the actions would run:
+// bucket creation (with object lock), versioning, lifecycle expiry, bucket policy, upload encryption,
+// and default object tags.
+//
+// Returns:
+//   - []string: The planned actions, or an empty slice when nothing is implied
+//
+// Example:
+//
+//	for _, step := range config.ProvisioningPlan() {
+//	    fmt.Println(step) // e.g., "create bucket 'uploads' in region 'eu-west-1'"
+//	}
+func (c *MinioConfig) ProvisioningPlan() []string {
+	plan := []string{}
+	if c.BucketName != "" {
+		step := fmt.Sprintf("create bucket '%s'", c.BucketName)
+		if c.Region != "" {
+			step += fmt.Sprintf(" in region '%s'", c.Region)
+		}
+		if c.ObjectLock {
+			step += " with object lock"
+		}
+		plan = append(plan, step)
+	}
+	if c.Versioning {
+		plan = append(plan, "enable versioning")
+	}
+	if c.ExpireAfterDays > 0 {
+		step := fmt.Sprintf("apply lifecycle expire after %d days", c.ExpireAfterDays)
+		if c.KeyPrefix != "" {
+			step += fmt.Sprintf(" under prefix '%s'", c.KeyPrefix)
+		}
+		plan = append(plan, step)
 	}
-	if options.AccessKey == "" {
-		return nil, errors.New("minio access key is required")
+	switch {
+	case c.BucketPolicy == "":
+	case c.IsPubliclyReadable():
+		plan = append(plan, "set policy public-read")
+	default:
+		plan = append(plan, "set custom bucket policy")
 	}
-	if options.SecretKey == "" {
-		return nil, errors.New("minio secret key is required")
+	switch c.SSEType {
+	case "":
+	case "SSE-KMS":
+		plan = append(plan, fmt.Sprintf("encrypt uploads with SSE-KMS using key '%s'", c.KMSKeyID))
+	default:
+		plan = append(plan, "encrypt uploads with "+c.SSEType)
 	}
-	if options.BucketName == "" {
-		return nil, errors.New("minio bucket name is required")
+	if len(c.DefaultObjectTags) > 0 {
+		tags := make([]string, 0, len(c.DefaultObjectTags))
+		for _, key := range slices.Sorted(maps.Keys(c.DefaultObjectTags)) {
+			tags = append(tags, key+"="+c.DefaultObjectTags[key])
+		}
+		plan = append(plan, "tag uploaded objects with "+strings.Join(tags, ", "))
 	}
-	return &MinioConfig{
-		Endpoint:   options.Endpoint,
-		AccessKey:  options.AccessKey,
-		SecretKey:  options.SecretKey,
-		UseSSL:     options.UseSSL,
-		BucketName: options.BucketName,
-		Region:     options.Region,
-	}, nil
+	return plan
 }
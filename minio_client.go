@@ -0,0 +1,512 @@
+package alex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+// NewClient creates a new Minio client from the MinioConfig, connected to its PrimaryEndpoint.
+// The endpoint is stripped of any "http://" or "https://" scheme and trailing path,
+// since the Minio SDK expects a bare "host:port" and derives the scheme from UseSSL.
+//
+// When STSEndpoint is set, the static keys are exchanged for temporary credentials with AssumeRole (of RoleARN, if set).
+// When PathStyle is set, the client is forced to path-style bucket lookup.
+// When TLSConfig or DNSResolver is set, it is applied to a copy of the SDK's default transport,
+// the resolver being installed on a dialer with the SDK's default timeouts.
+// When UserAgent is set, it is split into an application name and version and passed to SetAppInfo.
+// When TraceWriter is set, the transport is wrapped to dump every request and response to it, with the
+// Authorization, session token, and SSE-C key headers redacted.
+//
+// Returns:
+//   - *minio.Client: A Minio client configured with the endpoint, static credentials, SSL flag, and region
+//   - error: An error if the Minio client cannot be created
+//
+// Example:
+//
+//	client, err := config.NewClient()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *MinioConfig) NewClient() (*minio.Client, error) {
+	opts := &minio.Options{
+		Creds:      credentials.NewStaticV4(c.AccessKey, c.SecretKey, ""),
+		Secure:     c.UseSSL,
+		Region:     c.Region,
+		MaxRetries: c.MaxAttempts,
+	}
+	if c.MaxRetries > 0 {
+		opts.MaxRetries = c.MaxRetries
+	}
+	if c.STSEndpoint != "" {
+		creds, err := credentials.NewSTSAssumeRole(c.STSEndpoint, credentials.STSAssumeRoleOptions{
+			AccessKey: c.AccessKey,
+			SecretKey: c.SecretKey,
+			Location:  c.Region,
+			RoleARN:   c.RoleARN,
+		})
+		if err != nil {
+			return nil, err
+		}
+		opts.Creds = creds
+	}
+	if c.PathStyle {
+		opts.BucketLookup = minio.BucketLookupPath
+	}
+	if c.TLSConfig != nil || c.DNSResolver != nil {
+		transport, err := minio.DefaultTransport(c.UseSSL)
+		if err != nil {
+			return nil, err
+		}
+		if c.TLSConfig != nil {
+			transport.TLSClientConfig = c.TLSConfig.Clone()
+		}
+		if c.DNSResolver != nil {
+			transport.DialContext = (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+				Resolver:  c.DNSResolver,
+			}).DialContext
+		}
+		opts.Transport = transport
+	}
+	if c.TraceWriter != nil {
+		base := opts.Transport
+		if base == nil {
+			transport, err := minio.DefaultTransport(c.UseSSL)
+			if err != nil {
+				return nil, err
+			}
+			base = transport
+		}
+		opts.Transport = &minioTraceTransport{base: base, w: c.TraceWriter}
+	}
+	client, err := minio.New(minioEndpointHost(c.PrimaryEndpoint()), opts)
+	if err != nil {
+		return nil, err
+	}
+	if c.UserAgent != "" {
+		name, version, _ := strings.Cut(c.UserAgent, "/")
+		client.SetAppInfo(name, version)
+	}
+	return client, nil
+}
+
+// PresignedGetURL generates a presigned URL for downloading the given object from the configured bucket.
+// The key is prefixed with KeyPrefix. The expiry is rejected when it exceeds MaxPresignExpiry, unless MaxPresignExpiry is 0 (no cap).
+//
+// Parameters:
+//   - ctx: The context used for the request
+//   - key: The object key within the bucket
+//   - expiry: How long the presigned URL remains valid
+//
+// Returns:
+//   - string: The presigned URL
+//   - error: An error if the expiry exceeds the configured cap or the URL cannot be generated
+//
+// Example:
+//
+//	url, err := config.PresignedGetURL(ctx, "reports/2024.pdf", 15*time.Minute)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *MinioConfig) PresignedGetURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	if err := c.checkPresignExpiry(expiry); err != nil {
+		return "", err
+	}
+	client, err := c.NewClient()
+	if err != nil {
+		return "", err
+	}
+	u, err := client.PresignedGetObject(ctx, c.BucketName, c.ObjectKey(key), expiry, nil)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// PresignedPutURL generates a presigned URL for uploading the given object to the configured bucket.
+// The URL must be used with the PUT method. The key is prefixed with KeyPrefix. The expiry is rejected
+// when it exceeds MaxPresignExpiry, unless MaxPresignExpiry is 0 (no cap).
+//
+// Parameters:
+//   - ctx: The context used for the request
+//   - key: The object key within the bucket
+//   - expiry: How long the presigned URL remains valid
+//
+// Returns:
+//   - string: The presigned URL
+//   - error: An error if the expiry exceeds the configured cap or the URL cannot be generated
+//
+// Example:
+//
+//	url, err := config.PresignedPutURL(ctx, "avatars/42.png", 5*time.Minute)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *MinioConfig) PresignedPutURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	if err := c.checkPresignExpiry(expiry); err != nil {
+		return "", err
+	}
+	client, err := c.NewClient()
+	if err != nil {
+		return "", err
+	}
+	u, err := client.PresignedPutObject(ctx, c.BucketName, c.ObjectKey(key), expiry)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// PresignedPutURLWithHeaders generates a presigned upload URL like PresignedPutURL, with the given headers
+// included in the signature (e.g., "Content-Type" or "x-amz-meta-*"). The upload must then send exactly
+// these header values, or the server rejects the request.
+//
+// Parameters:
+//   - ctx: The context used for the request
+//   - key: The object key within the bucket
+//   - expiry: How long the presigned URL remains valid
+//   - headers: The headers to sign
+//
+// Returns:
+//   - string: The presigned URL, listing the headers in its X-Amz-SignedHeaders parameter
+//   - error: An error if a header name is empty, the expiry exceeds the configured cap, or the URL cannot be generated
+//
+// Example:
+//
+//	url, err := config.PresignedPutURLWithHeaders(ctx, "avatars/42.png", 5*time.Minute, http.Header{"Content-Type": {"image/png"}})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *MinioConfig) PresignedPutURLWithHeaders(ctx context.Context, key string, expiry time.Duration, headers http.Header) (string, error) {
+	for name := range headers {
+		if strings.TrimSpace(name) == "" {
+			return "", errors.New("minio presign header name is required")
+		}
+	}
+	if err := c.checkPresignExpiry(expiry); err != nil {
+		return "", err
+	}
+	client, err := c.NewClient()
+	if err != nil {
+		return "", err
+	}
+	u, err := client.PresignHeader(ctx, http.MethodPut, c.BucketName, c.ObjectKey(key), expiry, nil, headers)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// DeleteObject removes the given object from the configured bucket, prefixed with KeyPrefix.
+// The deletion is idempotent: a "not found" response from the server is treated as success.
+//
+// Parameters:
+//   - ctx: The context used for the request
+//   - key: The object key relative to KeyPrefix
+//
+// Returns:
+//   - error: An error if the object cannot be removed
+//
+// Example:
+//
+//	if err := config.DeleteObject(ctx, "tmp/upload-42"); err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *MinioConfig) DeleteObject(ctx context.Context, key string) error {
+	client, err := c.NewClient()
+	if err != nil {
+		return err
+	}
+	err = client.RemoveObject(ctx, c.BucketName, c.ObjectKey(key), minio.RemoveObjectOptions{})
+	if err != nil && minio.ToErrorResponse(err).StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+// AbortIncompleteUploads aborts the incomplete multipart uploads under KeyPrefix in the configured bucket
+// that were initiated more than olderThan ago, releasing the storage held by interrupted uploads.
+// Uploads initiated more recently are left alone, as they may still be in progress.
+//
+// Parameters:
+//   - ctx: The context used for the requests
+//   - olderThan: The minimum age of an upload to abort
+//
+// Returns:
+//   - int: The number of uploads aborted, including those aborted before an error occurred
+//   - error: An error if the client cannot be created, the uploads cannot be listed, or an abort fails
+//
+// Example:
+//
+//	aborted, err := config.AbortIncompleteUploads(ctx, 24*time.Hour)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	log.Printf("aborted %d stale uploads", aborted)
+func (c *MinioConfig) AbortIncompleteUploads(ctx context.Context, olderThan time.Duration) (int, error) {
+	client, err := c.NewClient()
+	if err != nil {
+		return 0, err
+	}
+	core := minio.Core{Client: client}
+	cutoff := time.Now().Add(-olderThan)
+	aborted := 0
+	for upload := range client.ListIncompleteUploads(ctx, c.BucketName, c.KeyPrefix, true) {
+		if upload.Err != nil {
+			return aborted, upload.Err
+		}
+		if !upload.Initiated.Before(cutoff) {
+			continue
+		}
+		if err := core.AbortMultipartUpload(ctx, c.BucketName, upload.Key, upload.UploadID); err != nil {
+			return aborted, fmt.Errorf("abort minio upload %s of %q: %w", upload.UploadID, upload.Key, err)
+		}
+		aborted++
+	}
+	return aborted, nil
+}
+
+// GetObjectIfNoneMatch downloads the given object from the configured bucket unless its ETag matches etag,
+// supporting cache validation with conditional reads. The key is prefixed with KeyPrefix.
+// When the server responds 304 Not Modified, no body is returned and the caller's cached copy is still current.
+//
+// Parameters:
+//   - ctx: The context used for the request
+//   - key: The object key within the bucket
+//   - etag: The ETag of the cached copy (sent as If-None-Match)
+//
+// Returns:
+//   - io.ReadCloser: The object body, which the caller must close, or nil when not modified
+//   - bool: true if the object was modified and a body is returned, false on 304 Not Modified
+//   - error: An error if the client cannot be created or the request fails
+//
+// Example:
+//
+//	body, modified, err := config.GetObjectIfNoneMatch(ctx, "reports/2024.pdf", cachedETag)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if modified {
+//	    defer body.Close()
+//	}
+func (c *MinioConfig) GetObjectIfNoneMatch(ctx context.Context, key, etag string) (io.ReadCloser, bool, error) {
+	client, err := c.NewClient()
+	if err != nil {
+		return nil, false, err
+	}
+	opts := minio.GetObjectOptions{}
+	if err := opts.SetMatchETagExcept(etag); err != nil {
+		return nil, false, err
+	}
+	object, err := client.GetObject(ctx, c.BucketName, c.ObjectKey(key), opts)
+	if err != nil {
+		return nil, false, err
+	}
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		if minio.ToErrorResponse(err).StatusCode == http.StatusNotModified {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return object, true, nil
+}
+
+// ServerSideEncryption returns the server-side encryption to set on PutObject options
+// (minio.PutObjectOptions.ServerSideEncryption) according to SSEType.
+//
+// Returns:
+//   - encrypt.ServerSide: The encryption producing the SSE request headers, or nil if SSEType is empty
+//   - error: An error if SSEType is unknown or the customer key is invalid
+//
+// Example:
+//
+//	sse, err := config.ServerSideEncryption()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	_, err = client.PutObject(ctx, config.BucketName, config.ObjectKey(key), body, size, minio.PutObjectOptions{ServerSideEncryption: sse})
+func (c *MinioConfig) ServerSideEncryption() (encrypt.ServerSide, error) {
+	switch c.SSEType {
+	case "":
+		return nil, nil
+	case "SSE-S3":
+		return encrypt.NewSSE(), nil
+	case "SSE-KMS":
+		return encrypt.NewSSEKMS(c.KMSKeyID, nil)
+	case "SSE-C":
+		return encrypt.NewSSEC(c.CustomerKey)
+	}
+	return nil, fmt.Errorf("minio sse type %q must be SSE-S3, SSE-KMS, or SSE-C", c.SSEType)
+}
+
+// ObjectKey returns the full object key for key, prefixed with KeyPrefix.
+// Exactly one slash separates the prefix from the key, whatever slashes either carries.
+//
+// Parameters:
+//   - key: The object key relative to KeyPrefix
+//
+// Returns:
+//   - string: The object key within the bucket
+func (c *MinioConfig) ObjectKey(key string) string {
+	if c.KeyPrefix == "" {
+		return key
+	}
+	return strings.TrimSuffix(c.KeyPrefix, "/") + "/" + strings.TrimPrefix(key, "/")
+}
+
+// ObjectURL returns the directly accessible URL of the given object, prefixed with KeyPrefix.
+// The scheme follows UseSSL. Path-style addressing yields "scheme://endpoint/bucket/key",
+// while virtual-hosted style yields "scheme://bucket.endpoint/key".
+// Such a URL only works for anonymous readers when the bucket is publicly readable, so an error is
+// returned for private configurations, whose objects must be shared with PresignedGetURL instead.
+//
+// Parameters:
+//   - objectKey: The object key relative to KeyPrefix
+//
+// Returns:
+//   - string: The public URL of the object
+//   - error: An error if the configuration is not publicly readable (see IsPubliclyReadable)
+//
+// Example:
+//
+//	objectURL, err := config.ObjectURL("avatars/42.png")
+//	if err != nil {
+//	    objectURL, err = config.PresignedGetURL(ctx, "avatars/42.png", time.Hour)
+//	}
+func (c *MinioConfig) ObjectURL(objectKey string) (string, error) {
+	if !c.IsPubliclyReadable() {
+		return "", fmt.Errorf("minio bucket %q is not publicly readable; use PresignedGetURL to share %q", c.BucketName, objectKey)
+	}
+	u := url.URL{Scheme: "http", Host: minioEndpointHost(c.PrimaryEndpoint())}
+	if c.UseSSL {
+		u.Scheme = "https"
+	}
+	key := strings.TrimPrefix(c.ObjectKey(objectKey), "/")
+	if c.PathStyle {
+		u.Path = "/" + c.BucketName + "/" + key
+	} else {
+		u.Host = c.BucketName + "." + u.Host
+		u.Path = "/" + key
+	}
+	return u.String(), nil
+}
+
+// ApplyPolicy sets the configured BucketPolicy on the bucket.
+// It does nothing when BucketPolicy is empty, and rejects a policy that is not well-formed JSON
+// before contacting the server.
+//
+// Parameters:
+//   - ctx: The context used for the request
+//
+// Returns:
+//   - error: An error if the policy is not valid JSON or the server rejects it
+//
+// Example:
+//
+//	if err := config.ApplyPolicy(ctx); err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *MinioConfig) ApplyPolicy(ctx context.Context) error {
+	if c.BucketPolicy == "" {
+		return nil
+	}
+	if !json.Valid([]byte(c.BucketPolicy)) {
+		return errors.New("minio bucket policy must be valid JSON")
+	}
+	client, err := c.NewClient()
+	if err != nil {
+		return err
+	}
+	return client.SetBucketPolicy(ctx, c.BucketName, c.BucketPolicy)
+}
+
+// checkPresignExpiry reports an error when expiry is not positive or exceeds MaxPresignExpiry.
+func (c *MinioConfig) checkPresignExpiry(expiry time.Duration) error {
+	if expiry <= 0 {
+		return errors.New("minio presign expiry must be greater than 0")
+	}
+	if c.MaxPresignExpiry > 0 && expiry > c.MaxPresignExpiry {
+		return fmt.Errorf("minio presign expiry %s exceeds the maximum of %s", expiry, c.MaxPresignExpiry)
+	}
+	return nil
+}
+
+// minioEndpointHost returns the "host:port" part of an endpoint, dropping any scheme and path.
+func minioEndpointHost(endpoint string) string {
+	if _, rest, ok := strings.Cut(endpoint, "://"); ok {
+		endpoint = rest
+	}
+	host, _, _ := strings.Cut(endpoint, "/")
+	return host
+}
+
+// minioTraceRedactedHeaders lists the headers whose values are replaced in HTTP traces,
+// since they carry credentials or encryption keys.
+var minioTraceRedactedHeaders = []string{
+	"Authorization",
+	"X-Amz-Security-Token",
+	"X-Amz-Server-Side-Encryption-Customer-Key",
+	"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key",
+}
+
+// minioTraceTransport is an http.RoundTripper dumping every request and response to w,
+// with the minioTraceRedactedHeaders values redacted. Response bodies are dumped for error statuses only.
+type minioTraceTransport struct {
+	base http.RoundTripper
+	w    io.Writer
+	mu   sync.Mutex
+}
+
+// RoundTrip sends the request with the base transport and writes its trace.
+func (t *minioTraceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	traced := req.Clone(req.Context())
+	traced.Header = redactTraceHeaders(req.Header)
+	reqDump, err := httputil.DumpRequestOut(traced, false)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body := resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent && resp.StatusCode != http.StatusNoContent
+	header := resp.Header
+	resp.Header = redactTraceHeaders(header)
+	respDump, err := httputil.DumpResponse(resp, body)
+	resp.Header = header
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fmt.Fprintf(t.w, "---------START-HTTP---------\n%s%s\n---------END-HTTP---------\n", reqDump, strings.TrimSuffix(string(respDump), "\r\n"))
+	return resp, nil
+}
+
+// redactTraceHeaders returns a copy of header with the minioTraceRedactedHeaders values replaced.
+func redactTraceHeaders(header http.Header) http.Header {
+	header = header.Clone()
+	for _, name := range minioTraceRedactedHeaders {
+		if header.Get(name) != "" {
+			header.Set(name, "**REDACTED**")
+		}
+	}
+	return header
+}
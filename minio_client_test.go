@@ -0,0 +1,596 @@
+package alex
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// newTestMinioConfig builds a Minio configuration with a fixed region, so that presigning needs no
+// bucket location lookup and works without a server.
+func newTestMinioConfig(t *testing.T, opts ...func(*MinioOptionBuilder)) *MinioConfig {
+	t.Helper()
+	builder := newTestMinioOptions().SetRegion("us-east-1")
+	for _, opt := range opts {
+		opt(builder)
+	}
+	config, err := NewMinioConfig(builder)
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v", err)
+	}
+	return config
+}
+
+func TestMinioConfigPresignedGetURLExpiryCap(t *testing.T) {
+	capped := newTestMinioConfig(t, func(b *MinioOptionBuilder) { b.SetMaxPresignExpiry(time.Hour) })
+	uncapped := newTestMinioConfig(t)
+	tests := []struct {
+		name    string
+		config  *MinioConfig
+		expiry  time.Duration
+		wantErr bool
+	}{
+		{"within cap", capped, 15 * time.Minute, false},
+		{"at cap", capped, time.Hour, false},
+		{"over cap", capped, 2 * time.Hour, true},
+		{"cap disabled", uncapped, 72 * time.Hour, false},
+		{"zero expiry", uncapped, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := tt.config.PresignedGetURL(context.Background(), "reports/2024.pdf", tt.expiry)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("PresignedGetURL() = %q, want an error", raw)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("PresignedGetURL() error = %v", err)
+			}
+			u, err := url.Parse(raw)
+			if err != nil {
+				t.Fatalf("url.Parse(%q) error = %v", raw, err)
+			}
+			if u.Path != "/bucket/reports/2024.pdf" {
+				t.Errorf("path = %q, want /bucket/reports/2024.pdf", u.Path)
+			}
+			if got, want := u.Query().Get("X-Amz-Expires"), strconv.Itoa(int(tt.expiry.Seconds())); got != want {
+				t.Errorf("X-Amz-Expires = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+// s3Request is a request received by a test S3 server.
+type s3Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   string
+}
+
+// newTestS3Server starts an httptest server answering every request with handler, and returns
+// a Minio configuration pointing at it together with the requests it received.
+func newTestS3Server(t *testing.T, handler http.HandlerFunc, opts ...func(*MinioOptionBuilder)) (*MinioConfig, *[]s3Request) {
+	t.Helper()
+	var mu sync.Mutex
+	var requests []s3Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		requests = append(requests, s3Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.Query(), Header: r.Header.Clone(), Body: string(body)})
+		mu.Unlock()
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	config := newTestMinioConfig(t, append([]func(*MinioOptionBuilder){func(b *MinioOptionBuilder) {
+		b.SetEndpoint(strings.TrimPrefix(server.URL, "http://")).SetMaxRetries(1)
+	}}, opts...)...)
+	return config, &requests
+}
+
+func TestMinioConfigApplyPolicy(t *testing.T) {
+	const policy = `{"Version":"2012-10-17","Statement":[]}`
+	config, requests := newTestS3Server(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, func(b *MinioOptionBuilder) { b.SetBucketPolicy(policy) })
+	if err := config.ApplyPolicy(context.Background()); err != nil {
+		t.Fatalf("ApplyPolicy() error = %v", err)
+	}
+	if len(*requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(*requests))
+	}
+	req := (*requests)[0]
+	if req.Method != http.MethodPut || strings.TrimSuffix(req.Path, "/") != "/bucket" || !req.Query.Has("policy") {
+		t.Errorf("request = %s %s?%s, want PUT /bucket?policy", req.Method, req.Path, req.Query.Encode())
+	}
+	if req.Body != policy {
+		t.Errorf("body = %q, want the policy", req.Body)
+	}
+}
+
+func TestMinioConfigApplyPolicyInvalidJSON(t *testing.T) {
+	config, requests := newTestS3Server(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	config.BucketPolicy = `{"Version":`
+	if err := config.ApplyPolicy(context.Background()); err == nil {
+		t.Fatal("ApplyPolicy() error = nil, want an error for invalid JSON")
+	}
+	if len(*requests) != 0 {
+		t.Errorf("got %d requests, want none for an invalid policy", len(*requests))
+	}
+
+	_, err := NewMinioConfig(newTestMinioOptions().SetBucketPolicy(`not json`))
+	requireValidationError(t, err, "BucketPolicy", RuleFormat)
+}
+
+func TestMinioConfigApplyPolicyWithoutPolicy(t *testing.T) {
+	config, requests := newTestS3Server(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	if err := config.ApplyPolicy(context.Background()); err != nil {
+		t.Fatalf("ApplyPolicy() error = %v", err)
+	}
+	if len(*requests) != 0 {
+		t.Errorf("got %d requests, want none without a policy", len(*requests))
+	}
+}
+
+func TestMinioConfigPresignedPutURL(t *testing.T) {
+	config := newTestMinioConfig(t, func(b *MinioOptionBuilder) {
+		b.SetKeyPrefix("uploads/").SetMaxPresignExpiry(time.Hour)
+	})
+	raw, err := config.PresignedPutURL(context.Background(), "/avatars/42.png", 5*time.Minute)
+	if err != nil {
+		t.Fatalf("PresignedPutURL() error = %v", err)
+	}
+	put, err := url.Parse(raw)
+	if err != nil {
+		t.Fatalf("url.Parse(%q) error = %v", raw, err)
+	}
+	if put.Path != "/bucket/uploads/avatars/42.png" {
+		t.Errorf("path = %q, want the prefixed key /bucket/uploads/avatars/42.png", put.Path)
+	}
+
+	// The HTTP method is part of the signature, so a PUT URL must not be signed like a GET one.
+	raw, err = config.PresignedGetURL(context.Background(), "/avatars/42.png", 5*time.Minute)
+	if err != nil {
+		t.Fatalf("PresignedGetURL() error = %v", err)
+	}
+	get, _ := url.Parse(raw)
+	if put.Query().Get("X-Amz-Signature") == get.Query().Get("X-Amz-Signature") {
+		t.Error("PUT and GET URLs share a signature, want the PUT method signed")
+	}
+
+	if _, err := config.PresignedPutURL(context.Background(), "avatars/42.png", 2*time.Hour); err == nil {
+		t.Error("PresignedPutURL() over the cap error = nil, want an error")
+	}
+}
+
+func TestMinioConfigPresignedPutURLWithHeaders(t *testing.T) {
+	config := newTestMinioConfig(t, func(b *MinioOptionBuilder) { b.SetMaxPresignExpiry(time.Hour) })
+	headers := http.Header{
+		"Content-Type":      {"image/png"},
+		"X-Amz-Meta-Author": {"alice"},
+	}
+	raw, err := config.PresignedPutURLWithHeaders(context.Background(), "avatars/42.png", 5*time.Minute, headers)
+	if err != nil {
+		t.Fatalf("PresignedPutURLWithHeaders() error = %v", err)
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		t.Fatalf("url.Parse(%q) error = %v", raw, err)
+	}
+	signed := strings.Split(u.Query().Get("X-Amz-SignedHeaders"), ";")
+	for _, want := range []string{"content-type", "host", "x-amz-meta-author"} {
+		if !slices.Contains(signed, want) {
+			t.Errorf("X-Amz-SignedHeaders = %v, want it to include %q", signed, want)
+		}
+	}
+
+	for _, headers := range []http.Header{{"": {"x"}}, {" ": {"x"}}} {
+		if _, err := config.PresignedPutURLWithHeaders(context.Background(), "a.png", time.Minute, headers); err == nil {
+			t.Errorf("PresignedPutURLWithHeaders(%v) error = nil, want an error for an empty header name", headers)
+		}
+	}
+	if _, err := config.PresignedPutURLWithHeaders(context.Background(), "a.png", 2*time.Hour, headers); err == nil {
+		t.Error("PresignedPutURLWithHeaders() over the cap error = nil, want an error")
+	}
+}
+
+// publicReadPolicy grants anonymous read access to every object of the "bucket" test bucket.
+const publicReadPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`
+
+func TestMinioConfigObjectURL(t *testing.T) {
+	tests := []struct {
+		name string
+		opts func(*MinioOptionBuilder)
+		key  string
+		want string
+	}{
+		{"path style", func(b *MinioOptionBuilder) { b.SetPathStyle(true).SetKeyPrefix("uploads/") }, "/avatars/42.png", "http://minio.local:9000/bucket/uploads/avatars/42.png"},
+		{"virtual hosted", func(b *MinioOptionBuilder) { b.SetKeyPrefix("/uploads") }, "avatars/42.png", "http://bucket.minio.local:9000/uploads/avatars/42.png"},
+		{"empty prefix", func(b *MinioOptionBuilder) { b.SetPathStyle(true) }, "/avatars/42.png", "http://minio.local:9000/bucket/avatars/42.png"},
+		{"ssl", func(b *MinioOptionBuilder) { b.SetUseSSL(true).SetEndpoint("https://minio.local/") }, "a.txt", "https://bucket.minio.local:443/a.txt"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := newTestMinioConfig(t, func(b *MinioOptionBuilder) {
+				b.SetEndpoint("minio.local:9000").SetBucketPolicy(publicReadPolicy)
+			}, tt.opts)
+			got, err := config.ObjectURL(tt.key)
+			if err != nil {
+				t.Fatalf("ObjectURL() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ObjectURL(%q) = %q, want %q", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinioConfigObjectURLPrivate(t *testing.T) {
+	config := newTestMinioConfig(t)
+	if got, err := config.ObjectURL("avatars/42.png"); err == nil {
+		t.Errorf("ObjectURL() = %q, want an error for a private bucket", got)
+	}
+}
+
+// writeS3Error writes an S3 error response with the given status and code.
+func writeS3Error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
+
+func TestMinioConfigDeleteObject(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		code    string
+		wantErr bool
+	}{
+		{"deleted", http.StatusNoContent, "", false},
+		{"not found", http.StatusNotFound, "NoSuchKey", false},
+		{"forbidden", http.StatusForbidden, "AccessDenied", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, requests := newTestS3Server(t, func(w http.ResponseWriter, r *http.Request) {
+				if tt.code == "" {
+					w.WriteHeader(tt.status)
+					return
+				}
+				writeS3Error(w, tt.status, tt.code)
+			}, func(b *MinioOptionBuilder) { b.SetKeyPrefix("tmp/") })
+			err := config.DeleteObject(context.Background(), "upload-42")
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("DeleteObject() error = %v, want error %t", err, tt.wantErr)
+			}
+			if len(*requests) == 0 {
+				t.Fatal("no request received")
+			}
+			if req := (*requests)[0]; req.Method != http.MethodDelete || req.Path != "/bucket/tmp/upload-42" {
+				t.Errorf("request = %s %s, want DELETE /bucket/tmp/upload-42", req.Method, req.Path)
+			}
+		})
+	}
+}
+
+func TestMinioConfigNewClientMaxRetries(t *testing.T) {
+	config, requests := newTestS3Server(t, func(w http.ResponseWriter, r *http.Request) {
+		writeS3Error(w, http.StatusServiceUnavailable, "SlowDown")
+	}, func(b *MinioOptionBuilder) { b.SetMaxRetries(2) })
+	if err := config.DeleteObject(context.Background(), "a.txt"); err == nil {
+		t.Fatal("DeleteObject() error = nil, want the 503 error")
+	}
+	if len(*requests) != 2 {
+		t.Errorf("got %d requests, want 2 attempts", len(*requests))
+	}
+}
+
+func TestMinioConfigNewClientUserAgent(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent string
+		wantApp   string
+	}{
+		{name: "app_info", userAgent: "billing-service/1.4.2", wantApp: " billing-service/1.4.2"},
+		{name: "sdk_default", userAgent: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, requests := newTestS3Server(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}, func(b *MinioOptionBuilder) { b.SetUserAgent(tt.userAgent) })
+			if err := config.DeleteObject(context.Background(), "a.txt"); err != nil {
+				t.Fatalf("DeleteObject() error = %v", err)
+			}
+			ua := (*requests)[0].Header.Get("User-Agent")
+			if !strings.Contains(ua, "minio-go/") {
+				t.Errorf("User-Agent = %q, want the SDK user agent", ua)
+			}
+			if tt.wantApp != "" && !strings.HasSuffix(ua, tt.wantApp) {
+				t.Errorf("User-Agent = %q, want it to end with %q", ua, tt.wantApp)
+			}
+		})
+	}
+}
+
+func TestMinioConfigNewClientResolver(t *testing.T) {
+	var mu sync.Mutex
+	dialed := 0
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			mu.Lock()
+			dialed++
+			mu.Unlock()
+			return nil, errors.New("resolver unavailable")
+		},
+	}
+	config := newTestMinioConfig(t, func(b *MinioOptionBuilder) {
+		b.SetEndpoint("minio.split-horizon.test:9000").SetResolver(resolver).SetMaxRetries(1)
+	})
+	if config.DNSResolver != resolver {
+		t.Fatalf("DNSResolver = %v, want the configured resolver", config.DNSResolver)
+	}
+	if err := config.DeleteObject(context.Background(), "a.txt"); err == nil {
+		t.Fatal("DeleteObject() error = nil, want a lookup error from the resolver")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if dialed == 0 {
+		t.Error("resolver was not used by the transport's DialContext")
+	}
+}
+
+func TestMinioConfigNewClientTraceWriter(t *testing.T) {
+	var trace strings.Builder
+	customerKey := []byte(strings.Repeat("k", 32))
+	config, requests := newTestS3Server(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/" {
+			w.Header().Set("Content-Type", "text/xml")
+			io.WriteString(w, assumeRoleResponse)
+			return
+		}
+		w.Header().Set("ETag", `"d41d8cd98f00b204e9800998ecf8427e"`)
+		w.WriteHeader(http.StatusOK)
+	}, func(b *MinioOptionBuilder) {
+		b.SetTraceWriter(&trace).SetSSEType("SSE-C").SetCustomerKey(customerKey)
+	})
+	config.STSEndpoint = "http://" + config.Endpoint
+	client, err := config.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	sse, err := config.ServerSideEncryption()
+	if err != nil {
+		t.Fatalf("ServerSideEncryption() error = %v", err)
+	}
+	if _, err := client.PutObject(context.Background(), config.BucketName, "a.txt", strings.NewReader("hello"), 5, minio.PutObjectOptions{ServerSideEncryption: sse}); err != nil {
+		t.Fatalf("PutObject() error = %v", err)
+	}
+	put := (*requests)[len(*requests)-1]
+	if put.Method != http.MethodPut || put.Header.Get("X-Amz-Security-Token") == "" || put.Header.Get("X-Amz-Server-Side-Encryption-Customer-Key") == "" {
+		t.Fatalf("request = %s %s with headers %v, want a PUT carrying the session token and SSE-C key", put.Method, put.Path, put.Header)
+	}
+	out := trace.String()
+	if !strings.Contains(out, "PUT /bucket/a.txt") || !strings.Contains(out, "200 OK") {
+		t.Errorf("trace = %q, want the request and response lines", out)
+	}
+	if !strings.Contains(out, "Authorization: **REDACTED**") {
+		t.Errorf("trace = %q, want the Authorization header redacted", out)
+	}
+	for _, secret := range []string{
+		config.AccessKey,
+		"ASIATEMPACCESS",
+		"temp-session-token",
+		base64.StdEncoding.EncodeToString(customerKey),
+	} {
+		if strings.Contains(out, secret) {
+			t.Errorf("trace = %q, want %q redacted", out, secret)
+		}
+	}
+}
+
+func TestMinioConfigGetObjectIfNoneMatch(t *testing.T) {
+	const etag = "d41d8cd98f00b204e9800998ecf8427e"
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"`+etag+`"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"`+etag+`"`)
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Set("Content-Length", "5")
+		w.Header().Set("Last-Modified", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC).Format(http.TimeFormat))
+		io.WriteString(w, "hello")
+	}
+	tests := []struct {
+		name     string
+		etag     string
+		wantBody string
+		wantOK   bool
+	}{
+		{name: "modified", etag: "stale", wantBody: "hello", wantOK: true},
+		{name: "not_modified", etag: etag, wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, requests := newTestS3Server(t, handler, func(b *MinioOptionBuilder) { b.SetKeyPrefix("cache/") })
+			body, ok, err := config.GetObjectIfNoneMatch(context.Background(), "page.html", tt.etag)
+			if err != nil {
+				t.Fatalf("GetObjectIfNoneMatch() error = %v", err)
+			}
+			if ok != tt.wantOK {
+				t.Fatalf("GetObjectIfNoneMatch() ok = %t, want %t", ok, tt.wantOK)
+			}
+			if !ok {
+				if body != nil {
+					t.Error("GetObjectIfNoneMatch() returned a body for 304")
+				}
+			} else {
+				defer body.Close()
+				data, err := io.ReadAll(body)
+				if err != nil || string(data) != tt.wantBody {
+					t.Errorf("body = %q, %v, want %q", data, err, tt.wantBody)
+				}
+			}
+			req := (*requests)[0]
+			if req.Path != "/bucket/cache/page.html" || req.Header.Get("If-None-Match") != `"`+tt.etag+`"` {
+				t.Errorf("request = %s %s (If-None-Match %q), want the prefixed key and the etag", req.Method, req.Path, req.Header.Get("If-None-Match"))
+			}
+		})
+	}
+}
+
+const assumeRoleResponse = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+<AssumeRoleResult><Credentials>
+<AccessKeyId>ASIATEMPACCESS</AccessKeyId>
+<SecretAccessKey>temp-secret-key</SecretAccessKey>
+<SessionToken>temp-session-token</SessionToken>
+<Expiration>2099-01-01T00:00:00Z</Expiration>
+</Credentials></AssumeRoleResult>
+</AssumeRoleResponse>`
+
+func TestMinioConfigNewClientAssumeRole(t *testing.T) {
+	config, requests := newTestS3Server(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/" {
+			w.Header().Set("Content-Type", "text/xml")
+			io.WriteString(w, assumeRoleResponse)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	config.STSEndpoint = "http://" + config.Endpoint
+	config.RoleARN = "arn:aws:iam::123456789012:role/uploader"
+	if err := config.DeleteObject(context.Background(), "a.txt"); err != nil {
+		t.Fatalf("DeleteObject() error = %v", err)
+	}
+	if len(*requests) != 2 {
+		t.Fatalf("got %d requests, want the AssumeRole call then the delete", len(*requests))
+	}
+	sts, del := (*requests)[0], (*requests)[1]
+	form, _ := url.ParseQuery(sts.Body)
+	if form.Get("Action") != "AssumeRole" || form.Get("RoleArn") != config.RoleARN {
+		t.Errorf("STS request body = %q, want an AssumeRole call for the role ARN", sts.Body)
+	}
+	if !strings.Contains(sts.Header.Get("Authorization"), "Credential=minioadmin/") {
+		t.Errorf("STS Authorization = %q, want it signed with the static keys", sts.Header.Get("Authorization"))
+	}
+	if !strings.Contains(del.Header.Get("Authorization"), "Credential=ASIATEMPACCESS/") || del.Header.Get("X-Amz-Security-Token") != "temp-session-token" {
+		t.Errorf("delete signed with %q (token %q), want the assumed-role credentials", del.Header.Get("Authorization"), del.Header.Get("X-Amz-Security-Token"))
+	}
+}
+
+func TestMinioConfigServerSideEncryption(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *MinioConfig
+		headers map[string]string
+	}{
+		{name: "none", config: &MinioConfig{}},
+		{name: "sse_s3", config: &MinioConfig{SSEType: "SSE-S3"}, headers: map[string]string{
+			"X-Amz-Server-Side-Encryption": "AES256",
+		}},
+		{name: "sse_kms", config: &MinioConfig{SSEType: "SSE-KMS", KMSKeyID: "alias/uploads"}, headers: map[string]string{
+			"X-Amz-Server-Side-Encryption":                "aws:kms",
+			"X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id": "alias/uploads",
+		}},
+		{name: "sse_c", config: &MinioConfig{SSEType: "SSE-C", CustomerKey: []byte(strings.Repeat("k", 32))}, headers: map[string]string{
+			"X-Amz-Server-Side-Encryption-Customer-Algorithm": "AES256",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sse, err := tt.config.ServerSideEncryption()
+			if err != nil {
+				t.Fatalf("ServerSideEncryption() error = %v", err)
+			}
+			if tt.headers == nil {
+				if sse != nil {
+					t.Errorf("ServerSideEncryption() = %v, want nil without an SSE type", sse)
+				}
+				return
+			}
+			h := http.Header{}
+			sse.Marshal(h)
+			for key, want := range tt.headers {
+				if got := h.Get(key); got != want {
+					t.Errorf("header %s = %q, want %q", key, got, want)
+				}
+			}
+		})
+	}
+	if _, err := (&MinioConfig{SSEType: "SSE-XYZ"}).ServerSideEncryption(); err == nil {
+		t.Error("ServerSideEncryption() with an unknown type error = nil")
+	}
+}
+
+func TestMinioConfigAbortIncompleteUploads(t *testing.T) {
+	now := time.Now().UTC()
+	listing := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<ListMultipartUploadsResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Bucket>bucket</Bucket>
+  <IsTruncated>false</IsTruncated>
+  <Upload><Key>stale.bin</Key><UploadId>stale-id</UploadId><Initiated>%s</Initiated></Upload>
+  <Upload><Key>fresh.bin</Key><UploadId>fresh-id</UploadId><Initiated>%s</Initiated></Upload>
+</ListMultipartUploadsResult>`, now.Add(-48*time.Hour).Format(time.RFC3339), now.Add(-time.Minute).Format(time.RFC3339))
+	config, requests := newTestS3Server(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/xml")
+			io.WriteString(w, listing)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	aborted, err := config.AbortIncompleteUploads(context.Background(), 24*time.Hour)
+	if err != nil {
+		t.Fatalf("AbortIncompleteUploads() error = %v", err)
+	}
+	if aborted != 1 {
+		t.Errorf("AbortIncompleteUploads() = %d, want 1", aborted)
+	}
+	var deletes []s3Request
+	for _, req := range *requests {
+		if req.Method == http.MethodDelete {
+			deletes = append(deletes, req)
+		}
+	}
+	if len(deletes) != 1 {
+		t.Fatalf("got %d aborts, want 1", len(deletes))
+	}
+	if deletes[0].Path != "/bucket/stale.bin" || deletes[0].Query.Get("uploadId") != "stale-id" {
+		t.Errorf("abort = DELETE %s?%s, want /bucket/stale.bin?uploadId=stale-id", deletes[0].Path, deletes[0].Query.Encode())
+	}
+}
+
+func TestMinioConfigAbortIncompleteUploadsError(t *testing.T) {
+	config, _ := newTestS3Server(t, func(w http.ResponseWriter, r *http.Request) {
+		writeS3Error(w, http.StatusForbidden, "AccessDenied")
+	})
+	if aborted, err := config.AbortIncompleteUploads(context.Background(), time.Hour); err == nil || aborted != 0 {
+		t.Errorf("AbortIncompleteUploads() = %d, %v, want 0 and an error", aborted, err)
+	}
+}
@@ -1,14 +1,61 @@
 package alex
 
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zeroxsolutions/strike/builderutil"
+)
+
 // MinioOption represents the configuration options for a Minio client.
 // It includes the endpoint, access key, secret key, use SSL, bucket name, and location.
 type MinioOption struct {
-	Endpoint   string // Endpoint is the URL of the Minio server (e.g., "https://minio.example.com").
-	AccessKey  string // AccessKey is the access key for the Minio server.
-	SecretKey  string // SecretKey is the secret key for the Minio server.
-	UseSSL     bool   // UseSSL is a flag indicating whether to use SSL for the connection.
-	BucketName string // BucketName is the name of the bucket to use.
-	Region     string // Region is the region of the bucket to use.
+	Endpoint              string            // Endpoint is the URL of the Minio server (e.g., "https://minio.example.com").
+	AccessKey             string            // AccessKey is the access key for the Minio server.
+	SecretKey             string            // SecretKey is the secret key for the Minio server.
+	UseSSL                bool              // UseSSL is a flag indicating whether to use SSL for the connection.
+	BucketName            string            // BucketName is the name of the bucket to use.
+	Region                string            // Region is the region of the bucket to use.
+	StrictScheme          bool              // StrictScheme is a flag indicating whether to reject an endpoint scheme or port conflicting with UseSSL.
+	AutoDetectRegion      bool              // AutoDetectRegion is a flag indicating that the client should look up the bucket's region.
+	RequireRegion         bool              // RequireRegion is a flag indicating whether an empty Region is rejected unless AutoDetectRegion is set.
+	MaxPresignExpiry      time.Duration     // MaxPresignExpiry is the maximum expiry allowed for presigned URLs (0 means no cap).
+	TLSConfig             *tls.Config       // TLSConfig is the TLS configuration used when UseSSL is enabled (nil uses the SDK default).
+	BucketPolicy          string            // BucketPolicy is the JSON bucket policy applied by ApplyPolicy (empty leaves the policy untouched).
+	ExpireAfterDays       int               // ExpireAfterDays is the number of days after which objects expire (0 means no expiry).
+	Enabled               *bool             // Enabled is a flag indicating whether the Minio backend is used (nil means enabled).
+	KeyPrefix             string            // KeyPrefix is the prefix prepended to every object key (e.g., "uploads/").
+	PathStyle             bool              // PathStyle is a flag indicating whether path-style addressing is used instead of virtual-hosted style.
+	RetryPolicy                             // RetryPolicy is the retry and backoff policy for Minio requests.
+	FollowRegionRedirects bool              // FollowRegionRedirects is a flag indicating whether requests follow region redirects for cross-region buckets.
+	MaxRetries            int               // MaxRetries is the maximum number of request retries (0 falls back to RetryPolicy.MaxAttempts).
+	MinKeyLength          int               // MinKeyLength overrides the minimum length of both the access and secret keys (0 uses the defaults).
+	UserAgent             string            // UserAgent is the application identifier appended to the SDK user-agent, in "name/version" form (e.g., "billing/1.4.0").
+	DNSResolver           *net.Resolver     // DNSResolver is the resolver used by the client's dialer (e.g., for split-horizon DNS); nil uses the system resolver.
+	TraceWriter           io.Writer         // TraceWriter receives an HTTP wire trace of every request and response, with credentials and keys redacted (nil disables tracing).
+	DefaultObjectTags     map[string]string // DefaultObjectTags are the tags applied to every object stored by the storage layer (e.g., for cost allocation).
+	ChecksumAlgorithm     string            // ChecksumAlgorithm is the content checksum requested on uploads: "CRC32", "CRC32C", "SHA1", "SHA256", or empty for none.
+	AccessKeyFile         string            // AccessKeyFile is the path of a file holding the access key, read at build time (conflicts with AccessKey).
+	SecretKeyFile         string            // SecretKeyFile is the path of a file holding the secret key, read at build time (conflicts with SecretKey).
+	Extra                 map[string]string // Extra holds backend-specific flags without a dedicated field yet; unknown keys are preserved.
+	STSEndpoint           string            // STSEndpoint is the URL of the STS service used to assume RoleARN (e.g., "https://sts.example.com").
+	RoleARN               string            // RoleARN is the ARN of the role assumed through STSEndpoint (requires STSEndpoint).
+	SSEType               string            // SSEType is the server-side encryption applied on uploads: "SSE-S3", "SSE-KMS", "SSE-C", or empty for none.
+	KMSKeyID              string            // KMSKeyID is the ID of the KMS key used by SSE-KMS encryption.
+	CustomerKey           []byte            // CustomerKey is the 32-byte key provided by the client for SSE-C encryption.
+	Endpoints             []string          // Endpoints are the failover gateway endpoints, used instead of Endpoint; the first one is the primary.
+	BucketOptional        bool              // BucketOptional is a flag relaxing the bucket name requirement, for clients working across buckets.
+	Versioning            bool              // Versioning is a flag indicating whether bucket versioning should be enabled when the bucket is provisioned.
+	ObjectLock            bool              // ObjectLock is a flag indicating whether object locking should be enabled when the bucket is created (requires Versioning).
+	*TLSOptions                             // TLSOptions is the TLS material the TLS configuration was loaded from with SetTLSOptions (nil otherwise).
+	TrimSpace             bool              // TrimSpace is a flag indicating whether the constructor trims leading and trailing white space from the string fields.
 }
 
 // MinioOptionBuilder provides a builder pattern for constructing MinioOption.
@@ -18,14 +65,38 @@ type MinioOptionBuilder struct {
 	Opts []func(*MinioOption) error // Opts contains the list of option functions to be applied
 }
 
+// SetExtra sets a backend-specific flag that has no dedicated field yet, as a forward-compatible escape hatch.
+// It appends an option function that adds the key/value pair to the Extra field of MinioOption.
+// The constructor does not interpret the flags but preserves them in the final configuration.
+//
+// Parameters:
+//   - key: The flag name (must not be empty); setting an existing key replaces its value
+//   - value: The flag value
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+func (builder *MinioOptionBuilder) SetExtra(key, value string) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		if args.Extra == nil {
+			args.Extra = make(map[string]string)
+		}
+		args.Extra[key] = value
+		return nil
+	})
+	return builder
+}
+
 // List returns the slice of option functions accumulated by the builder.
 // This method implements the builderutil.Lister interface, allowing the builder
 // to be used with the builderutil.Build function.
+// The functions are returned in the order the setters were called and builderutil.Build applies them
+// in that order, so when several setters touch the same field the last call wins. The returned slice
+// is a copy, so callers cannot reorder or drop the queued options.
 //
 // Returns:
 //   - []func(*MinioOption) error: A slice of option functions that can be applied to configure MinioOption
 func (builder *MinioOptionBuilder) List() []func(*MinioOption) error {
-	return builder.Opts
+	return slices.Clone(builder.Opts)
 }
 
 // NewMinioOption creates and returns a new instance of MinioOptionBuilder.
@@ -71,8 +142,53 @@ func (builder *MinioOptionBuilder) SetEndpoint(endpoint string) *MinioOptionBuil
 	return builder
 }
 
+// SetEndpointWithScheme configures both the endpoint and the use of SSL from a single endpoint URL.
+// It appends an option function that sets the Endpoint field of MinioOption to the host (and port)
+// of the URL, and the UseSSL field to true for the "https" scheme and false for "http".
+// An endpoint without a scheme is kept as is, with UseSSL set to false.
+//
+// Parameters:
+//   - endpoint: The endpoint of the Minio server, with or without scheme (e.g., "https://minio.example.com")
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioConfig(builder.SetEndpointWithScheme("https://minio.example.com:9000"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(config.Endpoint, config.UseSSL) // minio.example.com:9000 true
+func (builder *MinioOptionBuilder) SetEndpointWithScheme(endpoint string) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		if !strings.Contains(endpoint, "://") {
+			args.Endpoint = endpoint
+			args.UseSSL = false
+			return nil
+		}
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return fmt.Errorf("minio endpoint %q is invalid: %w", endpoint, err)
+		}
+		switch strings.ToLower(u.Scheme) {
+		case "https":
+			args.UseSSL = true
+		case "http":
+			args.UseSSL = false
+		default:
+			return fmt.Errorf("minio endpoint scheme %q is not supported (use http or https)", u.Scheme)
+		}
+		args.Endpoint = u.Host
+		return nil
+	})
+	return builder
+}
+
 // SetAccessKey configures the access key for the Minio client.
 // It appends an option function that sets the AccessKey field of MinioOption.
+// A "vault://path#key" reference is resolved at build time through the SecretResolver set with SetSecretResolver.
 //
 // Parameters:
 //   - accessKey: The access key for the Minio server
@@ -98,6 +214,7 @@ func (builder *MinioOptionBuilder) SetAccessKey(accessKey string) *MinioOptionBu
 
 // SetSecretKey configures the secret key for the Minio client.
 // It appends an option function that sets the SecretKey field of MinioOption.
+// A "vault://path#key" reference is resolved at build time through the SecretResolver set with SetSecretResolver.
 //
 // Parameters:
 //   - secretKey: The secret key for the Minio server
@@ -196,14 +313,1027 @@ func (builder *MinioOptionBuilder) SetRegion(region string) *MinioOptionBuilder
 	return builder
 }
 
+// SetStrictScheme configures whether conflicting endpoint scheme/port and SSL settings are rejected.
+// It appends an option function that sets the StrictScheme field of MinioOption.
+// When enabled, NewMinioConfig fails if UseSSL is true but the endpoint uses the "http" scheme
+// or a plaintext-looking port (80, 9000), or if UseSSL is false but the endpoint uses the
+// "https" scheme or a TLS-looking port (443, 9443). By default the check is permissive.
+//
+// Parameters:
+//   - strictScheme: A flag indicating whether to reject conflicting scheme and port combinations
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioConfig(builder.SetEndpoint("minio.example.com:443").SetUseSSL(true).SetStrictScheme(true))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Config: %+v\n", config)
+func (builder *MinioOptionBuilder) SetStrictScheme(strictScheme bool) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.StrictScheme = strictScheme
+		return nil
+	})
+	return builder
+}
+
+// SetAutoDetectRegion configures whether the bucket's region should be looked up by the client.
+// It appends an option function that sets the AutoDetectRegion field of MinioOption.
+// When enabled, Region is left empty (even with SetRequireRegion) and the flag is carried for the client to resolve
+// the bucket's region. Setting both an explicit region and auto-detection is rejected by NewMinioConfig.
+//
+// Parameters:
+//   - autoDetectRegion: A flag indicating whether the bucket's region should be auto-detected
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetAutoDetectRegion(true))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetAutoDetectRegion(autoDetectRegion bool) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.AutoDetectRegion = autoDetectRegion
+		return nil
+	})
+	return builder
+}
+
+// SetRequireRegion configures whether an explicit region is required.
+// It appends an option function that sets the RequireRegion field of MinioOption.
+// When enabled, NewMinioConfig rejects an empty Region unless auto-detection is enabled with SetAutoDetectRegion.
+//
+// Parameters:
+//   - requireRegion: A flag indicating whether an empty region should be rejected
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioConfig(builder.SetRegion("eu-west-1").SetRequireRegion(true))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (builder *MinioOptionBuilder) SetRequireRegion(requireRegion bool) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.RequireRegion = requireRegion
+		return nil
+	})
+	return builder
+}
+
+// SetMaxPresignExpiry configures the maximum expiry allowed for presigned URLs.
+// It appends an option function that sets the MaxPresignExpiry field of MinioOption.
+// A value of 0 disables the cap.
+//
+// Parameters:
+//   - maxPresignExpiry: The maximum expiry allowed for presigned URLs
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetMaxPresignExpiry(time.Hour))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetMaxPresignExpiry(maxPresignExpiry time.Duration) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.MaxPresignExpiry = maxPresignExpiry
+		return nil
+	})
+	return builder
+}
+
+// SetTLSConfigFunc configures a function assembling the TLS configuration for the Minio client.
+// It appends an option function that invokes fn at build time and sets the TLSConfig field of MinioOption,
+// which supports dynamic certificate loading (e.g., SPIFFE). An error returned by fn is surfaced by NewMinioConfig.
+//
+// Parameters:
+//   - fn: The function returning the TLS configuration to use
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioConfig(builder.SetUseSSL(true).SetTLSConfigFunc(loadTLSConfig))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Config: %+v\n", config)
+func (builder *MinioOptionBuilder) SetTLSConfigFunc(fn func() (*tls.Config, error)) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		if fn == nil {
+			return errors.New("minio tls config func is nil")
+		}
+		tlsConfig, err := fn()
+		if err != nil {
+			return fmt.Errorf("minio tls config func failed: %w", err)
+		}
+		args.TLSConfig = tlsConfig
+		args.TLSOptions = nil
+		return nil
+	})
+	return builder
+}
+
+// SetTLSOptions configures the TLS configuration of the Minio client from certificate, key, and CA files.
+// It appends an option function that builds the TLS options at build time, loads the material with
+// TLSOptions.NewTLSConfig, and sets both the TLSOptions and TLSConfig fields of MinioOption.
+// It replaces a TLS configuration set with SetTLSConfigFunc, and the other way around.
+// The configuration is only used when UseSSL is enabled.
+//
+// Parameters:
+//   - opts: The option listers configuring the TLS material (typically a TLSOptionsBuilder)
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioConfig(builder.SetUseSSL(true).SetTLSOptions(NewTLSOptions().SetCAFile("/etc/tls/ca.crt")))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Config: %+v\n", config)
+func (builder *MinioOptionBuilder) SetTLSOptions(opts ...builderutil.Lister[TLSOptions]) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		tlsOptions, err := builderutil.Build(opts...)
+		if err != nil {
+			return err
+		}
+		tlsConfig, err := tlsOptions.NewTLSConfig()
+		if err != nil {
+			return nestValidationError(err, "TLSOptions", "minio")
+		}
+		args.TLSConfig = tlsConfig
+		args.TLSOptions = tlsOptions
+		return nil
+	})
+	return builder
+}
+
+// SetBucketPolicy configures the JSON bucket policy for the Minio bucket.
+// It appends an option function that sets the BucketPolicy field of MinioOption.
+// The policy is validated as JSON by NewMinioConfig and applied with MinioConfig.ApplyPolicy.
+//
+// Parameters:
+//   - bucketPolicy: The JSON bucket policy document
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetBucketPolicy(`{"Version":"2012-10-17","Statement":[]}`))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetBucketPolicy(bucketPolicy string) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.BucketPolicy = bucketPolicy
+		return nil
+	})
+	return builder
+}
+
+// SetExpireAfterDays configures the lifecycle expiry for objects in the Minio bucket.
+// It appends an option function that sets the ExpireAfterDays field of MinioOption.
+// The value is carried for the provisioning layer to install a lifecycle rule on the bucket.
+//
+// Parameters:
+//   - expireAfterDays: The number of days after which objects expire (0 means no expiry)
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetExpireAfterDays(30))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetExpireAfterDays(expireAfterDays int) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.ExpireAfterDays = expireAfterDays
+		return nil
+	})
+	return builder
+}
+
+// SetEnabled configures whether the Minio backend is enabled.
+// It appends an option function that sets the Enabled field of MinioOption.
+// Backends are enabled by default; a disabled backend skips validation, so none of its fields are required.
+//
+// Parameters:
+//   - enabled: A flag indicating whether the Minio backend is used
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetEnabled(false))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetEnabled(enabled bool) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.Enabled = &enabled
+		return nil
+	})
+	return builder
+}
+
+// SetKeyPrefix configures the prefix prepended to every object key by the object helpers.
+// It appends an option function that sets the KeyPrefix field of MinioOption.
+//
+// Parameters:
+//   - keyPrefix: The prefix prepended to every object key
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetKeyPrefix("uploads/"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetKeyPrefix(keyPrefix string) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.KeyPrefix = keyPrefix
+		return nil
+	})
+	return builder
+}
+
+// SetPathStyle configures whether path-style addressing is used for the Minio bucket.
+// It appends an option function that sets the PathStyle field of MinioOption.
+// Path-style URLs have the form "scheme://endpoint/bucket/key", while virtual-hosted style URLs
+// have the form "scheme://bucket.endpoint/key".
+//
+// Parameters:
+//   - pathStyle: A flag indicating whether to use path-style addressing
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetPathStyle(true))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetPathStyle(pathStyle bool) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.PathStyle = pathStyle
+		return nil
+	})
+	return builder
+}
+
+// SetRetryPolicy configures the retry and backoff policy for Minio requests.
+// It appends an option function that sets the embedded RetryPolicy of MinioOption.
+// The MaxAttempts value is passed to the Minio client as its maximum number of retries.
+//
+// Parameters:
+//   - retryPolicy: The retry and backoff policy
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetRetryPolicy(RetryPolicy{MaxAttempts: 3}))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetRetryPolicy(retryPolicy RetryPolicy) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.RetryPolicy = retryPolicy
+		return nil
+	})
+	return builder
+}
+
+// SetFollowRegionRedirects configures whether requests follow region redirects for cross-region buckets.
+// It appends an option function that sets the FollowRegionRedirects field of MinioOption.
+//
+// Parameters:
+//   - followRegionRedirects: A flag indicating whether to follow region redirects
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetFollowRegionRedirects(true))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetFollowRegionRedirects(followRegionRedirects bool) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.FollowRegionRedirects = followRegionRedirects
+		return nil
+	})
+	return builder
+}
+
+// SetMaxRetries configures the maximum number of request retries of the Minio client.
+// It appends an option function that sets the MaxRetries field of MinioOption.
+// When set, it takes precedence over RetryPolicy.MaxAttempts.
+//
+// Parameters:
+//   - maxRetries: The maximum number of request retries
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetMaxRetries(5))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetMaxRetries(maxRetries int) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.MaxRetries = maxRetries
+		return nil
+	})
+	return builder
+}
+
+// SetMinKeyLength configures the minimum length enforced on the access and secret keys.
+// It appends an option function that sets the MinKeyLength field of MinioOption.
+// By default the access key must be at least MinioMinAccessKeyLength characters and the secret key
+// at least MinioMinSecretKeyLength characters; a positive value replaces both minimums for exotic backends.
+//
+// Parameters:
+//   - minKeyLength: The minimum length of the access and secret keys (0 uses the defaults)
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetMinKeyLength(1))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetMinKeyLength(minKeyLength int) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.MinKeyLength = minKeyLength
+		return nil
+	})
+	return builder
+}
+
+// SetUserAgent configures the application identifier reported in the user-agent of Minio requests.
+// It appends an option function that sets the UserAgent field of MinioOption.
+// The value is applied with SetAppInfo when the client is created; when empty, the SDK's user-agent is left unchanged.
+//
+// Parameters:
+//   - userAgent: The application identifier in "name/version" form; the version part is optional
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetUserAgent("billing/1.4.0"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetUserAgent(userAgent string) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.UserAgent = userAgent
+		return nil
+	})
+	return builder
+}
+
+// SetResolver configures a custom DNS resolver for the Minio client.
+// It appends an option function that sets the DNSResolver field of MinioOption.
+// The resolver is installed on the dialer of the client's transport when the client is created.
+//
+// Parameters:
+//   - resolver: The resolver used to look up the endpoint host, or nil for the system resolver
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetResolver(&net.Resolver{PreferGo: true}))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetResolver(resolver *net.Resolver) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.DNSResolver = resolver
+		return nil
+	})
+	return builder
+}
+
+// SetTraceWriter configures a writer receiving an HTTP wire trace of the Minio client, for diagnosing signing issues.
+// It appends an option function that sets the TraceWriter field of MinioOption.
+// Request and response lines and headers are dumped with the Authorization signature redacted.
+//
+// Parameters:
+//   - w: The writer receiving the trace (e.g., os.Stderr), or nil to disable tracing
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetTraceWriter(os.Stderr))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetTraceWriter(w io.Writer) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.TraceWriter = w
+		return nil
+	})
+	return builder
+}
+
+// AddObjectTag adds a default tag applied to every object stored by the storage layer.
+// It appends an option function that adds the key/value pair to the DefaultObjectTags field of MinioOption.
+//
+// Parameters:
+//   - key: The tag key (1 to 128 characters); adding an existing key replaces its value
+//   - value: The tag value (1 to 256 characters)
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.AddObjectTag("team", "billing"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) AddObjectTag(key, value string) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		if args.DefaultObjectTags == nil {
+			args.DefaultObjectTags = make(map[string]string)
+		}
+		args.DefaultObjectTags[key] = value
+		return nil
+	})
+	return builder
+}
+
+// SetChecksumAlgorithm configures the content checksum requested on uploads for server-side integrity verification.
+// It appends an option function that sets the ChecksumAlgorithm field of MinioOption.
+//
+// Parameters:
+//   - algorithm: The checksum algorithm: "CRC32", "CRC32C", "SHA1", "SHA256", or empty to disable checksums
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetChecksumAlgorithm("CRC32C"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetChecksumAlgorithm(algorithm string) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.ChecksumAlgorithm = algorithm
+		return nil
+	})
+	return builder
+}
+
+// SetAccessKeyFile configures a file holding the access key for the Minio client.
+// It appends an option function that sets the AccessKeyFile field of MinioOption.
+// The file is read by NewMinioConfig, ignoring a trailing newline; setting both a access key and a access key file is an error.
+//
+// Parameters:
+//   - path: The path of the file holding the access key (e.g., a mounted Kubernetes secret)
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetAccessKeyFile("/run/secrets/minio-access-key"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetAccessKeyFile(path string) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.AccessKeyFile = path
+		return nil
+	})
+	return builder
+}
+
+// SetSecretKeyFile configures a file holding the secret key for the Minio client.
+// It appends an option function that sets the SecretKeyFile field of MinioOption.
+// The file is read by NewMinioConfig, ignoring a trailing newline; setting both a secret key and a secret key file is an error.
+//
+// Parameters:
+//   - path: The path of the file holding the secret key (e.g., a mounted Kubernetes secret)
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetSecretKeyFile("/run/secrets/minio-secret-key"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetSecretKeyFile(path string) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.SecretKeyFile = path
+		return nil
+	})
+	return builder
+}
+
+// SetSTSEndpoint configures the STS service used to obtain temporary credentials with AssumeRole.
+// It appends an option function that sets the STSEndpoint field of MinioOption.
+// When set, NewClient exchanges the access and secret keys for temporary credentials through this endpoint.
+//
+// Parameters:
+//   - endpoint: The URL of the STS service
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetSTSEndpoint("https://sts.example.com"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetSTSEndpoint(endpoint string) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.STSEndpoint = endpoint
+		return nil
+	})
+	return builder
+}
+
+// SetRoleARN configures the role assumed through the STS endpoint.
+// It appends an option function that sets the RoleARN field of MinioOption.
+// A role ARN requires an STS endpoint set with SetSTSEndpoint.
+//
+// Parameters:
+//   - roleARN: The ARN of the role to assume (e.g., "arn:aws:iam::123456789012:role/uploader")
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetRoleARN("arn:aws:iam::123456789012:role/uploader"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetRoleARN(roleARN string) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.RoleARN = roleARN
+		return nil
+	})
+	return builder
+}
+
+// SetSSEType configures the server-side encryption applied to uploaded objects.
+// It appends an option function that sets the SSEType field of MinioOption.
+// "SSE-KMS" requires a key ID set with SetKMSKeyID, and "SSE-C" a 32-byte key set with SetCustomerKey.
+//
+// Parameters:
+//   - sseType: The server-side encryption type: "SSE-S3", "SSE-KMS", "SSE-C", or empty to disable encryption
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetSSEType("SSE-KMS"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetSSEType(sseType string) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.SSEType = sseType
+		return nil
+	})
+	return builder
+}
+
+// SetKMSKeyID configures the KMS key used by SSE-KMS encryption.
+// It appends an option function that sets the KMSKeyID field of MinioOption.
+//
+// Parameters:
+//   - keyID: The ID of the KMS key
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetKMSKeyID("my-minio-key"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetKMSKeyID(keyID string) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.KMSKeyID = keyID
+		return nil
+	})
+	return builder
+}
+
+// SetCustomerKey configures the client-provided key used by SSE-C encryption.
+// It appends an option function that sets the CustomerKey field of MinioOption.
+//
+// Parameters:
+//   - key: The 32-byte encryption key
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetCustomerKey(key))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetCustomerKey(key []byte) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.CustomerKey = key
+		return nil
+	})
+	return builder
+}
+
+// AddEndpoint adds a failover endpoint for the Minio client.
+// It appends an option function that appends the endpoint to the Endpoints field of MinioOption.
+// The first endpoint added is the primary one; Endpoints cannot be combined with SetEndpoint.
+//
+// Parameters:
+//   - endpoint: The URL of a Minio gateway (e.g., "minio-1.example.com:9000")
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.AddEndpoint("minio-1.example.com:9000").AddEndpoint("minio-2.example.com:9000"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) AddEndpoint(endpoint string) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.Endpoints = append(args.Endpoints, endpoint)
+		return nil
+	})
+	return builder
+}
+
+// SetBucketOptional configures whether the bucket name may be left empty.
+// It appends an option function that sets the BucketOptional field of MinioOption.
+// Only the bucket name requirement is relaxed; every other check still applies, and a bucket name that is set
+// is used as usual.
+//
+// Parameters:
+//   - optional: A flag indicating whether the bucket name may be left empty
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetBucketOptional(true))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetBucketOptional(optional bool) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.BucketOptional = optional
+		return nil
+	})
+	return builder
+}
+
+// SetVersioning configures whether bucket versioning should be enabled when the bucket is provisioned.
+// It appends an option function that sets the Versioning field of MinioOption.
+// The flag is carried for the provisioning layer and listed in ProvisioningPlan; the client does not change
+// the bucket versioning state itself.
+//
+// Parameters:
+//   - versioning: A flag indicating whether bucket versioning should be enabled
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetVersioning(true))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetVersioning(versioning bool) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.Versioning = versioning
+		return nil
+	})
+	return builder
+}
+
+// SetObjectLock configures whether object locking should be enabled when the bucket is created.
+// It appends an option function that sets the ObjectLock field of MinioOption.
+// Object locking requires bucket versioning, so SetVersioning(true) must also be set.
+//
+// Parameters:
+//   - objectLock: A flag indicating whether object locking should be enabled
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption()
+//	config, err := NewMinioOption(builder.SetObjectLock(true))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Minio Option: %+v\n", config)
+func (builder *MinioOptionBuilder) SetObjectLock(objectLock bool) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.ObjectLock = objectLock
+		return nil
+	})
+	return builder
+}
+
+// SetTrimSpace configures whether leading and trailing white space is trimmed from the string fields before validation.
+// It appends an option function that sets the TrimSpace field of MinioOption.
+// Trimming is off by default so that values are used exactly as given; enabling it guards against
+// an access or secret key copied with a trailing newline silently breaking the configuration.
+//
+// Parameters:
+//   - trim: A flag indicating whether the string fields are trimmed
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+func (builder *MinioOptionBuilder) SetTrimSpace(trim bool) *MinioOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *MinioOption) error {
+		args.TrimSpace = trim
+		return nil
+	})
+	return builder
+}
+
+// From seeds the builder from an already populated MinioOption, such as the one returned by MinioDefaults.
+// It enqueues the matching setter for each non-zero field of the given options,
+// which eases migration from manual struct construction to the builder.
+// A TLS configuration without TLSOptions is applied as is through SetTLSConfigFunc.
+//
+// Parameters:
+//   - o: The options whose non-zero fields should be applied
+//
+// Returns:
+//   - *MinioOptionBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewMinioOption().From(MinioDefaults())
+//	config, err := NewMinioConfig(builder.SetEndpoint("minio.example.com").SetAccessKey("accessKey").SetSecretKey("secretKey").SetBucketName("bucketName"))
+func (builder *MinioOptionBuilder) From(o MinioOption) *MinioOptionBuilder {
+	if o.Endpoint != "" {
+		builder.SetEndpoint(o.Endpoint)
+	}
+	if o.AccessKey != "" {
+		builder.SetAccessKey(o.AccessKey)
+	}
+	if o.SecretKey != "" {
+		builder.SetSecretKey(o.SecretKey)
+	}
+	if o.UseSSL {
+		builder.SetUseSSL(o.UseSSL)
+	}
+	if o.BucketName != "" {
+		builder.SetBucketName(o.BucketName)
+	}
+	if o.Region != "" {
+		builder.SetRegion(o.Region)
+	}
+	if o.StrictScheme {
+		builder.SetStrictScheme(o.StrictScheme)
+	}
+	if o.AutoDetectRegion {
+		builder.SetAutoDetectRegion(o.AutoDetectRegion)
+	}
+	if o.RequireRegion {
+		builder.SetRequireRegion(o.RequireRegion)
+	}
+	if o.MaxPresignExpiry != 0 {
+		builder.SetMaxPresignExpiry(o.MaxPresignExpiry)
+	}
+	if o.BucketPolicy != "" {
+		builder.SetBucketPolicy(o.BucketPolicy)
+	}
+	if o.ExpireAfterDays != 0 {
+		builder.SetExpireAfterDays(o.ExpireAfterDays)
+	}
+	if o.Enabled != nil {
+		builder.SetEnabled(*o.Enabled)
+	}
+	if o.KeyPrefix != "" {
+		builder.SetKeyPrefix(o.KeyPrefix)
+	}
+	if o.PathStyle {
+		builder.SetPathStyle(o.PathStyle)
+	}
+	if o.RetryPolicy != (RetryPolicy{}) {
+		builder.SetRetryPolicy(o.RetryPolicy)
+	}
+	if o.FollowRegionRedirects {
+		builder.SetFollowRegionRedirects(o.FollowRegionRedirects)
+	}
+	if o.MaxRetries != 0 {
+		builder.SetMaxRetries(o.MaxRetries)
+	}
+	if o.MinKeyLength != 0 {
+		builder.SetMinKeyLength(o.MinKeyLength)
+	}
+	if o.UserAgent != "" {
+		builder.SetUserAgent(o.UserAgent)
+	}
+	if o.DNSResolver != nil {
+		builder.SetResolver(o.DNSResolver)
+	}
+	if o.TraceWriter != nil {
+		builder.SetTraceWriter(o.TraceWriter)
+	}
+	for key, value := range o.DefaultObjectTags {
+		builder.AddObjectTag(key, value)
+	}
+	if o.ChecksumAlgorithm != "" {
+		builder.SetChecksumAlgorithm(o.ChecksumAlgorithm)
+	}
+	if o.AccessKeyFile != "" {
+		builder.SetAccessKeyFile(o.AccessKeyFile)
+	}
+	if o.SecretKeyFile != "" {
+		builder.SetSecretKeyFile(o.SecretKeyFile)
+	}
+	for key, value := range o.Extra {
+		builder.SetExtra(key, value)
+	}
+	if o.STSEndpoint != "" {
+		builder.SetSTSEndpoint(o.STSEndpoint)
+	}
+	if o.RoleARN != "" {
+		builder.SetRoleARN(o.RoleARN)
+	}
+	if o.SSEType != "" {
+		builder.SetSSEType(o.SSEType)
+	}
+	if o.KMSKeyID != "" {
+		builder.SetKMSKeyID(o.KMSKeyID)
+	}
+	if o.CustomerKey != nil {
+		builder.SetCustomerKey(o.CustomerKey)
+	}
+	for _, endpoint := range o.Endpoints {
+		builder.AddEndpoint(endpoint)
+	}
+	if o.BucketOptional {
+		builder.SetBucketOptional(o.BucketOptional)
+	}
+	if o.Versioning {
+		builder.SetVersioning(o.Versioning)
+	}
+	if o.ObjectLock {
+		builder.SetObjectLock(o.ObjectLock)
+	}
+	if o.TrimSpace {
+		builder.SetTrimSpace(o.TrimSpace)
+	}
+	if o.TLSOptions != nil {
+		builder.SetTLSOptions(NewTLSOptions().From(*o.TLSOptions))
+	} else if o.TLSConfig != nil {
+		tlsConfig := o.TLSConfig
+		builder.SetTLSConfigFunc(func() (*tls.Config, error) { return tlsConfig, nil })
+	}
+	return builder
+}
+
 // MinioConfig represents the final Minio configuration used for establishing connections.
 // This struct is created from MinioOption after validation and contains all the necessary
 // parameters for connecting to a Minio server.
 type MinioConfig struct {
-	Endpoint   string // Endpoint is the URL of the Minio server (e.g., "https://minio.example.com").
-	AccessKey  string // AccessKey is the access key for the Minio server.
-	SecretKey  string // SecretKey is the secret key for the Minio server.
-	UseSSL     bool   // UseSSL is a flag indicating whether to use SSL for the connection.
-	BucketName string // BucketName is the name of the bucket to use.
-	Region     string // Region is the region of the bucket to use.
+	Endpoint              string            // Endpoint is the URL of the Minio server (e.g., "https://minio.example.com").
+	AccessKey             string            // AccessKey is the access key for the Minio server.
+	SecretKey             string            // SecretKey is the secret key for the Minio server.
+	UseSSL                bool              // UseSSL is a flag indicating whether to use SSL for the connection.
+	BucketName            string            // BucketName is the name of the bucket to use.
+	Region                string            // Region is the region of the bucket to use.
+	StrictScheme          bool              // StrictScheme is a flag indicating whether to reject an endpoint scheme or port conflicting with UseSSL.
+	AutoDetectRegion      bool              // AutoDetectRegion is a flag indicating that the client should look up the bucket's region.
+	RequireRegion         bool              // RequireRegion is a flag indicating whether an empty Region is rejected unless AutoDetectRegion is set.
+	MaxPresignExpiry      time.Duration     // MaxPresignExpiry is the maximum expiry allowed for presigned URLs (0 means no cap).
+	TLSConfig             *tls.Config       // TLSConfig is the TLS configuration used when UseSSL is enabled (nil uses the SDK default).
+	BucketPolicy          string            // BucketPolicy is the JSON bucket policy applied by ApplyPolicy (empty leaves the policy untouched).
+	ExpireAfterDays       int               // ExpireAfterDays is the number of days after which objects expire (0 means no expiry).
+	Enabled               bool              // Enabled is a flag indicating whether the Minio backend is used.
+	KeyPrefix             string            // KeyPrefix is the prefix prepended to every object key (e.g., "uploads/").
+	PathStyle             bool              // PathStyle is a flag indicating whether path-style addressing is used instead of virtual-hosted style.
+	RetryPolicy                             // RetryPolicy is the retry and backoff policy for Minio requests.
+	FollowRegionRedirects bool              // FollowRegionRedirects is a flag indicating whether requests follow region redirects for cross-region buckets.
+	MaxRetries            int               // MaxRetries is the maximum number of request retries (0 falls back to RetryPolicy.MaxAttempts).
+	MinKeyLength          int               // MinKeyLength overrides the minimum length of both the access and secret keys (0 uses the defaults).
+	UserAgent             string            // UserAgent is the application identifier appended to the SDK user-agent, in "name/version" form (e.g., "billing/1.4.0").
+	DNSResolver           *net.Resolver     // DNSResolver is the resolver used by the client's dialer (e.g., for split-horizon DNS); nil uses the system resolver.
+	TraceWriter           io.Writer         // TraceWriter receives an HTTP wire trace of every request and response, with credentials and keys redacted (nil disables tracing).
+	DefaultObjectTags     map[string]string // DefaultObjectTags are the tags applied to every object stored by the storage layer (e.g., for cost allocation).
+	ChecksumAlgorithm     string            // ChecksumAlgorithm is the content checksum requested on uploads: "CRC32", "CRC32C", "SHA1", "SHA256", or empty for none.
+	Extra                 map[string]string // Extra holds backend-specific flags without a dedicated field yet; unknown keys are preserved.
+	STSEndpoint           string            // STSEndpoint is the URL of the STS service used to assume RoleARN (e.g., "https://sts.example.com").
+	RoleARN               string            // RoleARN is the ARN of the role assumed through STSEndpoint (requires STSEndpoint).
+	SSEType               string            // SSEType is the server-side encryption applied on uploads: "SSE-S3", "SSE-KMS", "SSE-C", or empty for none.
+	KMSKeyID              string            // KMSKeyID is the ID of the KMS key used by SSE-KMS encryption.
+	CustomerKey           []byte            // CustomerKey is the 32-byte key provided by the client for SSE-C encryption.
+	Endpoints             []string          // Endpoints are the failover gateway endpoints, used instead of Endpoint; the first one is the primary.
+	BucketOptional        bool              // BucketOptional is a flag relaxing the bucket name requirement, for clients working across buckets.
+	Versioning            bool              // Versioning is a flag indicating whether bucket versioning should be enabled when the bucket is provisioned.
+	ObjectLock            bool              // ObjectLock is a flag indicating whether object locking should be enabled when the bucket is created (requires Versioning).
+	*TLSOptions                             // TLSOptions is the TLS material the TLS configuration was loaded from with SetTLSOptions (nil otherwise).
 }
@@ -0,0 +1,608 @@
+package alex
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// newTestMinioOptions returns a builder holding a minimal valid Minio configuration.
+func newTestMinioOptions() *MinioOptionBuilder {
+	return NewMinioOption().
+		SetEndpoint("localhost:9000").
+		SetAccessKey("minioadmin").
+		SetSecretKey("minioadmin").
+		SetBucketName("bucket")
+}
+
+func TestNewMinioConfigStrictScheme(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		useSSL   bool
+		wantErr  bool
+	}{
+		{"ssl with plaintext port", "minio.local:9000", true, true},
+		{"ssl with port 80", "minio.local:80", true, true},
+		{"ssl with http scheme", "http://minio.local:9443", true, true},
+		{"plaintext with tls port", "minio.local:9443", false, true},
+		{"plaintext with port 443", "minio.local:443", false, true},
+		{"plaintext with https scheme", "https://minio.local:9000", false, true},
+		{"ssl with tls port", "minio.local:9443", true, false},
+		{"plaintext with plaintext port", "minio.local:9000", false, false},
+		{"custom port", "minio.local:8080", true, false},
+		{"no port", "minio.local", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMinioConfig(newTestMinioOptions().
+				SetEndpoint(tt.endpoint).
+				SetUseSSL(tt.useSSL).
+				SetStrictScheme(true))
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("NewMinioConfig() error = %v", err)
+				}
+				return
+			}
+			requireValidationError(t, err, "Endpoint", RuleConflict)
+		})
+	}
+}
+
+func TestNewMinioConfigPermissiveScheme(t *testing.T) {
+	config, err := NewMinioConfig(newTestMinioOptions().SetEndpoint("minio.local:9000").SetUseSSL(true))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v, want the mismatch to be accepted without StrictScheme", err)
+	}
+	if config.StrictScheme {
+		t.Error("StrictScheme = true, want false by default")
+	}
+}
+
+func TestNewMinioConfigAutoDetectRegion(t *testing.T) {
+	config, err := NewMinioConfig(newTestMinioOptions().SetAutoDetectRegion(true).SetRequireRegion(true))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v, want an empty region to be accepted with auto-detect", err)
+	}
+	if !config.AutoDetectRegion || !config.RequireRegion || config.Region != "" {
+		t.Errorf("AutoDetectRegion = %t, RequireRegion = %t, Region = %q, want true, true and empty", config.AutoDetectRegion, config.RequireRegion, config.Region)
+	}
+
+	_, err = NewMinioConfig(newTestMinioOptions().SetRequireRegion(true))
+	requireValidationError(t, err, "Region", RuleRequired)
+
+	_, err = NewMinioConfig(newTestMinioOptions().SetAutoDetectRegion(true).SetRegion("us-east-1"))
+	requireValidationError(t, err, "Region", RuleConflict)
+
+	config, err = NewMinioConfig(newTestMinioOptions().SetRegion("us-east-1").SetRequireRegion(true))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v, want an explicit region to be accepted without auto-detect", err)
+	}
+	if config.AutoDetectRegion {
+		t.Error("AutoDetectRegion = true, want false by default")
+	}
+
+	if _, err := NewMinioConfig(newTestMinioOptions()); err != nil {
+		t.Errorf("NewMinioConfig() error = %v, want an empty region to be accepted without RequireRegion", err)
+	}
+}
+
+func TestMinioOptionBuilderSetTLSConfigFunc(t *testing.T) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	config, err := NewMinioConfig(newTestMinioOptions().
+		SetUseSSL(true).
+		SetEndpoint("minio.local:9443").
+		SetTLSConfigFunc(func() (*tls.Config, error) { return tlsConfig, nil }))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v", err)
+	}
+	if config.TLSConfig != tlsConfig {
+		t.Error("TLSConfig is not the one returned by the func")
+	}
+
+	errNoCert := errors.New("no certificate")
+	_, err = NewMinioConfig(newTestMinioOptions().
+		SetTLSConfigFunc(func() (*tls.Config, error) { return nil, errNoCert }))
+	if !errors.Is(err, errNoCert) {
+		t.Errorf("NewMinioConfig() error = %v, want it to wrap the func error", err)
+	}
+
+	if _, err := NewMinioConfig(newTestMinioOptions().SetTLSConfigFunc(nil)); err == nil {
+		t.Error("NewMinioConfig() error = nil, want an error for a nil func")
+	}
+}
+
+func TestMinioConfigEqualIgnoringSecrets(t *testing.T) {
+	build := func(secretKey, bucket string) *MinioConfig {
+		t.Helper()
+		config, err := NewMinioConfig(newTestMinioOptions().SetSecretKey(secretKey).SetBucketName(bucket))
+		if err != nil {
+			t.Fatalf("NewMinioConfig() error = %v", err)
+		}
+		return config
+	}
+	base := build("old-secret", "bucket")
+	if !base.EqualIgnoringSecrets(build("new-secret", "bucket")) {
+		t.Error("configs differing only by secret key are not equal")
+	}
+	if base.EqualIgnoringSecrets(build("old-secret", "other")) {
+		t.Error("configs differing by bucket are equal")
+	}
+	if base.EqualIgnoringSecrets(nil) || !(*MinioConfig)(nil).EqualIgnoringSecrets(nil) {
+		t.Error("nil handling is wrong")
+	}
+	withTLS := func() *MinioConfig {
+		t.Helper()
+		config, err := NewMinioConfig(newTestMinioOptions().SetTLSConfigFunc(func() (*tls.Config, error) {
+			return &tls.Config{VerifyConnection: func(tls.ConnectionState) error { return nil }}, nil
+		}))
+		if err != nil {
+			t.Fatalf("NewMinioConfig() error = %v", err)
+		}
+		return config
+	}
+	if !withTLS().EqualIgnoringSecrets(withTLS()) {
+		t.Error("configs with TLS callbacks are not equal")
+	}
+	if base.EqualIgnoringSecrets(withTLS()) {
+		t.Error("configs differing by the presence of TLSConfig are equal")
+	}
+}
+
+func TestNewMinioConfigExpireAfterDays(t *testing.T) {
+	config, err := NewMinioConfig(newTestMinioOptions().SetExpireAfterDays(7))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v", err)
+	}
+	if config.ExpireAfterDays != 7 {
+		t.Errorf("ExpireAfterDays = %d, want 7", config.ExpireAfterDays)
+	}
+	config, err = NewMinioConfig(newTestMinioOptions())
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v", err)
+	}
+	if config.ExpireAfterDays != 0 {
+		t.Errorf("ExpireAfterDays = %d, want 0 (no expiry) by default", config.ExpireAfterDays)
+	}
+	_, err = NewMinioConfig(newTestMinioOptions().SetExpireAfterDays(-1))
+	requireValidationError(t, err, "ExpireAfterDays", RuleMin)
+}
+
+func TestMinioOptionBuilderRetryToggles(t *testing.T) {
+	config, err := NewMinioConfig(newTestMinioOptions().SetFollowRegionRedirects(true).SetMaxRetries(4))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v", err)
+	}
+	if !config.FollowRegionRedirects || config.MaxRetries != 4 {
+		t.Errorf("FollowRegionRedirects, MaxRetries = %v, %d, want true, 4", config.FollowRegionRedirects, config.MaxRetries)
+	}
+	_, err = NewMinioConfig(newTestMinioOptions().SetMaxRetries(-1))
+	requireValidationError(t, err, "MaxRetries", RuleMin)
+}
+
+func TestMinioConfigMinKeyLength(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *MinioOptionBuilder
+		field   string
+	}{
+		{name: "short_access_key", builder: newTestMinioOptions().SetAccessKey("ab"), field: "AccessKey"},
+		{name: "short_secret_key", builder: newTestMinioOptions().SetSecretKey("1234567"), field: "SecretKey"},
+		{name: "override_raises_minimum", builder: newTestMinioOptions().SetMinKeyLength(16), field: "AccessKey"},
+		{name: "negative_override", builder: newTestMinioOptions().SetMinKeyLength(-1), field: "MinKeyLength"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMinioConfig(tt.builder)
+			requireValidationError(t, err, tt.field, RuleMin)
+		})
+	}
+	config, err := NewMinioConfig(newTestMinioOptions().SetAccessKey("a").SetSecretKey("b").SetMinKeyLength(1))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() with MinKeyLength 1 error = %v", err)
+	}
+	if config.MinKeyLength != 1 {
+		t.Errorf("MinKeyLength = %d, want 1", config.MinKeyLength)
+	}
+	if _, err := NewMinioConfig(newTestMinioOptions().SetAccessKey("abc").SetSecretKey("12345678")); err != nil {
+		t.Errorf("NewMinioConfig() with keys at the default minimum error = %v", err)
+	}
+}
+
+func TestMinioOptionBuilderBuildContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	missing := filepath.Join(t.TempDir(), "secret_key")
+	_, err := newTestMinioOptions().SetSecretKey("").SetSecretKeyFile(missing).BuildContext(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("BuildContext() error = %v, want context.Canceled before the key file is read", err)
+	}
+	if _, err := newTestMinioOptions().BuildContext(context.Background()); err != nil {
+		t.Errorf("BuildContext() error = %v", err)
+	}
+}
+
+func TestMinioOptionBuilderAddObjectTag(t *testing.T) {
+	config, err := NewMinioConfig(newTestMinioOptions().
+		AddObjectTag("team", "billing").
+		AddObjectTag("env", "prod").
+		AddObjectTag(strings.Repeat("k", MinioMaxTagKeyLength), strings.Repeat("é", MinioMaxTagValueLength)))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v", err)
+	}
+	if len(config.DefaultObjectTags) != 3 || config.DefaultObjectTags["team"] != "billing" || config.DefaultObjectTags["env"] != "prod" {
+		t.Errorf("DefaultObjectTags = %v, want the three added tags", config.DefaultObjectTags)
+	}
+
+	tests := []struct {
+		name       string
+		key, value string
+		rule       string
+	}{
+		{name: "empty_key", key: "", value: "billing", rule: RuleRequired},
+		{name: "empty_value", key: "team", value: "", rule: RuleRequired},
+		{name: "long_key", key: strings.Repeat("k", MinioMaxTagKeyLength+1), value: "billing", rule: RuleMax},
+		{name: "long_value", key: "team", value: strings.Repeat("v", MinioMaxTagValueLength+1), rule: RuleMax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMinioConfig(newTestMinioOptions().AddObjectTag(tt.key, tt.value))
+			requireValidationError(t, err, "DefaultObjectTags", tt.rule)
+		})
+	}
+}
+
+func TestOverlaySecrets(t *testing.T) {
+	base, err := NewMinioConfig(newTestMinioOptions().
+		SetAccessKey("file-access").
+		SetSecretKey("file-secret").
+		SetRegion("eu-west-1").
+		SetKeyPrefix("uploads/").
+		SetExpireAfterDays(30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	secrets := &MinioConfig{Endpoint: "other:9000", BucketName: "other", AccessKey: "vault-access", SecretKey: "vault-secret"}
+	before := *base
+
+	merged := OverlaySecrets(base, secrets)
+	if merged == base {
+		t.Fatal("OverlaySecrets() returned base, want a copy")
+	}
+	want := before
+	want.AccessKey, want.SecretKey = "vault-access", "vault-secret"
+	if !reflect.DeepEqual(*merged, want) {
+		t.Errorf("OverlaySecrets() = %+v, want the base with the vault credentials", *merged)
+	}
+	if !reflect.DeepEqual(*base, before) {
+		t.Error("OverlaySecrets() modified base")
+	}
+
+	partial := OverlaySecrets(base, &MinioConfig{SecretKey: "vault-secret"})
+	if partial.AccessKey != "file-access" || partial.SecretKey != "vault-secret" {
+		t.Errorf("AccessKey, SecretKey = %q, %q, want the base access key kept and the secret key replaced", partial.AccessKey, partial.SecretKey)
+	}
+	if kept := OverlaySecrets(base, nil); kept == base || !reflect.DeepEqual(*kept, before) {
+		t.Error("OverlaySecrets(base, nil) is not an unmodified copy of base")
+	}
+	if OverlaySecrets(nil, secrets) != nil {
+		t.Error("OverlaySecrets(nil, secrets) != nil")
+	}
+}
+
+func TestMinioOptionBuilderSetChecksumAlgorithm(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		wantErr   bool
+	}{
+		{algorithm: "", wantErr: false},
+		{algorithm: "CRC32", wantErr: false},
+		{algorithm: "CRC32C", wantErr: false},
+		{algorithm: "SHA1", wantErr: false},
+		{algorithm: "SHA256", wantErr: false},
+		{algorithm: "MD5", wantErr: true},
+		{algorithm: "sha256", wantErr: true},
+		{algorithm: "CRC64NVME", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			config, err := NewMinioConfig(newTestMinioOptions().SetChecksumAlgorithm(tt.algorithm))
+			if tt.wantErr {
+				requireValidationError(t, err, "ChecksumAlgorithm", RuleFormat)
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewMinioConfig() error = %v", err)
+			}
+			if config.ChecksumAlgorithm != tt.algorithm {
+				t.Errorf("ChecksumAlgorithm = %q, want %q", config.ChecksumAlgorithm, tt.algorithm)
+			}
+		})
+	}
+}
+
+func TestNewMinioConfigNormalizesEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		useSSL   bool
+		want     string
+	}{
+		{name: "trailing_slash", endpoint: "minio.example.com:9000/", want: "minio.example.com:9000"},
+		{name: "trailing_slashes", endpoint: "minio.example.com:9000//", want: "minio.example.com:9000"},
+		{name: "no_port_plaintext", endpoint: "minio.example.com", want: "minio.example.com:80"},
+		{name: "no_port_ssl", endpoint: "minio.example.com", useSSL: true, want: "minio.example.com:443"},
+		{name: "no_port_trailing_slash_ssl", endpoint: "minio.example.com/", useSSL: true, want: "minio.example.com:443"},
+		{name: "ipv6_no_port", endpoint: "[::1]", want: "[::1]:80"},
+		{name: "explicit_port_kept", endpoint: "minio.example.com:9443", useSSL: true, want: "minio.example.com:9443"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := NewMinioConfig(newTestMinioOptions().SetEndpoint(tt.endpoint).SetUseSSL(tt.useSSL))
+			if err != nil {
+				t.Fatalf("NewMinioConfig() error = %v", err)
+			}
+			if config.Endpoint != tt.want {
+				t.Errorf("Endpoint = %q, want %q", config.Endpoint, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewMinioConfigRoleARNRequiresSTSEndpoint(t *testing.T) {
+	_, err := NewMinioConfig(newTestMinioOptions().SetRoleARN("arn:aws:iam::123456789012:role/uploader"))
+	requireValidationError(t, err, "STSEndpoint", RuleRequired)
+
+	config, err := NewMinioConfig(newTestMinioOptions().
+		SetRoleARN("arn:aws:iam::123456789012:role/uploader").
+		SetSTSEndpoint("https://sts.example.com"))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v", err)
+	}
+	if config.STSEndpoint != "https://sts.example.com" || config.RoleARN != "arn:aws:iam::123456789012:role/uploader" {
+		t.Errorf("STSEndpoint, RoleARN = %q, %q, want the configured values", config.STSEndpoint, config.RoleARN)
+	}
+}
+
+func TestNewMinioConfigServerSideEncryption(t *testing.T) {
+	key32 := []byte(strings.Repeat("k", 32))
+	tests := []struct {
+		name    string
+		builder *MinioOptionBuilder
+		field   string
+		rule    string
+	}{
+		{name: "sse_s3", builder: newTestMinioOptions().SetSSEType("SSE-S3")},
+		{name: "sse_kms", builder: newTestMinioOptions().SetSSEType("SSE-KMS").SetKMSKeyID("alias/uploads")},
+		{name: "sse_kms_without_key_id", builder: newTestMinioOptions().SetSSEType("SSE-KMS"), field: "KMSKeyID", rule: RuleRequired},
+		{name: "sse_c", builder: newTestMinioOptions().SetSSEType("SSE-C").SetCustomerKey(key32)},
+		{name: "sse_c_without_key", builder: newTestMinioOptions().SetSSEType("SSE-C"), field: "CustomerKey", rule: RuleFormat},
+		{name: "sse_c_short_key", builder: newTestMinioOptions().SetSSEType("SSE-C").SetCustomerKey(key32[:16]), field: "CustomerKey", rule: RuleFormat},
+		{name: "unknown_type", builder: newTestMinioOptions().SetSSEType("SSE-XYZ"), field: "SSEType", rule: RuleFormat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMinioConfig(tt.builder)
+			if tt.field == "" {
+				if err != nil {
+					t.Fatalf("NewMinioConfig() error = %v", err)
+				}
+				return
+			}
+			requireValidationError(t, err, tt.field, tt.rule)
+		})
+	}
+}
+
+func TestMinioOptionBuilderSetEndpointWithScheme(t *testing.T) {
+	tests := []struct {
+		name         string
+		endpoint     string
+		wantEndpoint string
+		wantSSL      bool
+	}{
+		{"https", "https://minio.example.com:9443", "minio.example.com:9443", true},
+		{"http", "http://minio.example.com:9000", "minio.example.com:9000", false},
+		{"uppercase scheme", "HTTPS://minio.example.com:9443", "minio.example.com:9443", true},
+		{"no scheme", "minio.example.com:9000", "minio.example.com:9000", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := NewMinioConfig(newTestMinioOptions().SetUseSSL(!tt.wantSSL).SetEndpointWithScheme(tt.endpoint))
+			if err != nil {
+				t.Fatalf("NewMinioConfig() error = %v", err)
+			}
+			if config.Endpoint != tt.wantEndpoint || config.UseSSL != tt.wantSSL {
+				t.Errorf("Endpoint, UseSSL = %q, %v, want %q, %v", config.Endpoint, config.UseSSL, tt.wantEndpoint, tt.wantSSL)
+			}
+		})
+	}
+	if _, err := NewMinioConfig(newTestMinioOptions().SetEndpointWithScheme("ftp://minio.example.com")); err == nil {
+		t.Error("NewMinioConfig() error = nil, want an unsupported scheme error")
+	}
+}
+
+func TestNewMinioConfigEndpoints(t *testing.T) {
+	config, err := NewMinioConfig(NewMinioOption().
+		AddEndpoint("minio-1.example.com:9000").
+		AddEndpoint("minio-2.example.com:9000").
+		SetAccessKey("minioadmin").
+		SetSecretKey("minioadmin").
+		SetBucketName("bucket"))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v", err)
+	}
+	if got, want := config.PrimaryEndpoint(), "minio-1.example.com:9000"; got != want {
+		t.Errorf("PrimaryEndpoint() = %q, want %q", got, want)
+	}
+	all := config.AllEndpoints()
+	if want := []string{"minio-1.example.com:9000", "minio-2.example.com:9000"}; !reflect.DeepEqual(all, want) {
+		t.Errorf("AllEndpoints() = %v, want %v", all, want)
+	}
+	all[0] = "changed"
+	if config.Endpoints[0] != "minio-1.example.com:9000" {
+		t.Error("AllEndpoints() returned the config's own slice")
+	}
+
+	single, err := NewMinioConfig(newTestMinioOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if single.PrimaryEndpoint() != "localhost:9000" || !reflect.DeepEqual(single.AllEndpoints(), []string{"localhost:9000"}) {
+		t.Errorf("PrimaryEndpoint(), AllEndpoints() = %q, %v, want the single endpoint", single.PrimaryEndpoint(), single.AllEndpoints())
+	}
+}
+
+func TestNewMinioConfigEndpointsValidation(t *testing.T) {
+	_, err := NewMinioConfig(newTestMinioOptions().AddEndpoint("minio-2.example.com:9000"))
+	requireValidationError(t, err, "Endpoints", RuleConflict)
+	_, err = NewMinioConfig(newTestMinioOptions().SetEndpoint(""))
+	requireValidationError(t, err, "Endpoint", RuleRequired)
+	_, err = NewMinioConfig(newTestMinioOptions().SetEndpoint("").AddEndpoint("minio-1.example.com:9000").AddEndpoint(""))
+	requireValidationError(t, err, "Endpoints[1]", RuleRequired)
+	_, err = NewMinioConfig(newTestMinioOptions().SetEndpoint("").AddEndpoint("minio-1.example.com:9000").AddEndpoint("minio-2.example.com:9000").SetUseSSL(true).SetStrictScheme(true))
+	requireValidationError(t, err, "Endpoint", RuleConflict)
+}
+
+func TestMinioOptionBuilderSetBucketOptional(t *testing.T) {
+	_, err := NewMinioConfig(newTestMinioOptions().SetBucketName(""))
+	requireValidationError(t, err, "BucketName", RuleRequired)
+
+	config, err := NewMinioConfig(newTestMinioOptions().SetBucketName("").SetBucketOptional(true))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v, want an empty bucket name to be accepted", err)
+	}
+	if config.BucketName != "" || !config.BucketOptional {
+		t.Errorf("BucketName, BucketOptional = %q, %v, want an empty optional bucket", config.BucketName, config.BucketOptional)
+	}
+
+	config, err = NewMinioConfig(newTestMinioOptions().SetBucketOptional(true))
+	if err != nil || config.BucketName != "bucket" {
+		t.Errorf("NewMinioConfig() = %+v, %v, want a set bucket name to be kept", config, err)
+	}
+
+	_, err = NewMinioConfig(newTestMinioOptions().SetBucketName("").SetBucketOptional(true).SetAccessKey(""))
+	requireValidationError(t, err, "AccessKey", RuleRequired)
+	_, err = NewMinioConfig(newTestMinioOptions().SetBucketOptional(true).SetBucketOptional(false).SetBucketName(""))
+	requireValidationError(t, err, "BucketName", RuleRequired)
+}
+
+func TestMinioConfigIsPubliclyReadable(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    string
+		keyPrefix string
+		want      bool
+	}{
+		{name: "no_policy", want: false},
+		{name: "public_read", policy: `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`, want: true},
+		{name: "single_statement", policy: `{"Statement":{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::bucket/*"]}}`, want: true},
+		{name: "wildcard_action", policy: `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:*","Resource":"*"}]}`, want: true},
+		{name: "covering_prefix", policy: `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/public/*"}]}`, keyPrefix: "public/avatars", want: true},
+		{name: "other_prefix", policy: `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/public/*"}]}`, keyPrefix: "private", want: false},
+		{name: "other_bucket", policy: `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::other/*"}]}`, want: false},
+		{name: "named_principal", policy: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123:root"]},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`, want: false},
+		{name: "list_only", policy: `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:ListBucket","Resource":"arn:aws:s3:::bucket"}]}`, want: false},
+		{name: "conditional", policy: `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*","Condition":{"IpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}]}`, want: false},
+		{name: "denied", policy: `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"},{"Effect":"Deny","Principal":"*","Action":"s3:GetObject","Resource":"*"}]}`, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := newTestMinioOptions().SetKeyPrefix(tt.keyPrefix)
+			if tt.policy != "" {
+				builder.SetBucketPolicy(tt.policy)
+			}
+			config, err := NewMinioConfig(builder)
+			if err != nil {
+				t.Fatalf("NewMinioConfig() error = %v", err)
+			}
+			if got := config.IsPubliclyReadable(); got != tt.want {
+				t.Errorf("IsPubliclyReadable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinioConfigProvisioningPlan(t *testing.T) {
+	config, err := NewMinioConfig(newTestMinioOptions().
+		SetBucketName("uploads").
+		SetRegion("eu-west-1").
+		SetObjectLock(true).
+		SetVersioning(true).
+		SetKeyPrefix("tmp/").
+		SetExpireAfterDays(30).
+		SetBucketPolicy(`{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::uploads/*"}]}`).
+		SetSSEType("SSE-KMS").
+		SetKMSKeyID("alias/uploads").
+		AddObjectTag("team", "billing").
+		AddObjectTag("env", "prod"))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v", err)
+	}
+	want := []string{
+		"create bucket 'uploads' in region 'eu-west-1' with object lock",
+		"enable versioning",
+		"apply lifecycle expire after 30 days under prefix 'tmp/'",
+		"set policy public-read",
+		"encrypt uploads with SSE-KMS using key 'alias/uploads'",
+		"tag uploaded objects with env=prod, team=billing",
+	}
+	if got := config.ProvisioningPlan(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ProvisioningPlan() = %q, want %q", got, want)
+	}
+
+	config, err = NewMinioConfig(newTestMinioOptions().SetBucketPolicy(`{"Statement":[]}`).SetSSEType("SSE-S3"))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v", err)
+	}
+	want = []string{"create bucket 'bucket'", "set custom bucket policy", "encrypt uploads with SSE-S3"}
+	if got := config.ProvisioningPlan(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ProvisioningPlan() = %q, want %q", got, want)
+	}
+
+	config, err = NewMinioConfig(newTestMinioOptions().SetBucketName("").SetBucketOptional(true))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v", err)
+	}
+	if got := config.ProvisioningPlan(); got == nil || len(got) != 0 {
+		t.Errorf("ProvisioningPlan() = %#v, want an empty slice", got)
+	}
+}
+
+func TestNewMinioConfigObjectLockRequiresVersioning(t *testing.T) {
+	tests := []struct {
+		name       string
+		objectLock bool
+		versioning bool
+		wantErr    bool
+	}{
+		{name: "neither"},
+		{name: "versioning_only", versioning: true},
+		{name: "object_lock_with_versioning", objectLock: true, versioning: true},
+		{name: "object_lock_only", objectLock: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := NewMinioConfig(newTestMinioOptions().SetObjectLock(tt.objectLock).SetVersioning(tt.versioning))
+			if tt.wantErr {
+				requireValidationError(t, err, "Versioning", RuleRequired)
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewMinioConfig() error = %v", err)
+			}
+			if config.ObjectLock != tt.objectLock || config.Versioning != tt.versioning {
+				t.Errorf("ObjectLock, Versioning = %v, %v, want %v, %v", config.ObjectLock, config.Versioning, tt.objectLock, tt.versioning)
+			}
+			if got := slices.Contains(config.ProvisioningPlan(), "enable versioning"); got != tt.versioning {
+				t.Errorf("ProvisioningPlan() enables versioning = %v, want %v", got, tt.versioning)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package alex
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"github.com/zeroxsolutions/strike/builderutil"
+)
+
+// NewOpenSearchConfig creates a new OpenSearchConfig from OpenSearchOption by applying the provided option functions.
+// It uses the builderutil package to build the configuration options in a functional options pattern,
+// then creates and returns a final OpenSearchConfig instance.
+//
+// Validation rules:
+//   - At least one address is required, and each address must be an http:// or https:// URL with a host
+//   - Service, when set, must be "es" or "aoss"
+//   - SigV4 requires a region and a service
+//
+// Parameters:
+//   - opts: Variable number of option functions that configure the OpenSearchOption
+//
+// Returns:
+//   - *OpenSearchConfig: A pointer to the final OpenSearch configuration instance
+//   - error: An error if the configuration building process fails or validation fails
+//
+// Example:
+//
+//	builder := NewOpenSearchOption()
+//	config, err := NewOpenSearchConfig(builder.
+//	    AddAddress("https://abc123.eu-west-1.aoss.amazonaws.com").
+//	    SetSigV4(true).SetSigV4Region("eu-west-1").SetService("aoss"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewOpenSearchConfig(opts ...builderutil.Lister[OpenSearchOption]) (*OpenSearchConfig, error) {
+	options, err := builderutil.Build(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if options.TrimSpace {
+		trimSpace(&options.Username, &options.Password, &options.SigV4Region, &options.Service)
+		options.Addresses = trimSpaceAll(options.Addresses)
+	}
+	if options.Password, err = resolveSecret(context.Background(), options.Password); err != nil {
+		return nil, err
+	}
+	config := &OpenSearchConfig{
+		Addresses:   options.Addresses,
+		Username:    options.Username,
+		Password:    options.Password,
+		SigV4:       options.SigV4,
+		SigV4Region: options.SigV4Region,
+		Service:     options.Service,
+		Extra:       options.Extra,
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	notifyBuild(config)
+	return config, nil
+}
+
+// Validate checks that the OpenSearchConfig satisfies the rules enforced by its constructor.
+// It performs no I/O, so it can be used to re-check a configuration at any time.
+//
+// Returns:
+//   - error: A *ValidationError describing the first rule the configuration violates, or nil if it is valid
+func (c *OpenSearchConfig) Validate() error {
+	if len(c.Addresses) == 0 {
+		return newValidationError("Addresses", RuleRequired, "at least one opensearch address is required")
+	}
+	for i, address := range c.Addresses {
+		u, err := url.Parse(address)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return newValidationError(fmt.Sprintf("Addresses[%d]", i), RuleFormat, fmt.Sprintf("opensearch address[%d] must be an http:// or https:// URL with a host", i))
+		}
+	}
+	switch c.Service {
+	case "", "es", "aoss":
+	default:
+		return newValidationError("Service", RuleFormat, fmt.Sprintf("opensearch service %q must be es or aoss", c.Service))
+	}
+	if err := requireIf(c.SigV4, c.SigV4Region, "SigV4Region", "opensearch sigv4 region is required when sigv4 is enabled"); err != nil {
+		return err
+	}
+	if err := requireIf(c.SigV4, c.Service, "Service", "opensearch service is required when sigv4 is enabled"); err != nil {
+		return err
+	}
+	if err := validateExtra(c.Extra, "opensearch"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Labels returns identifying, non-secret key/value pairs describing the configuration.
+// The addresses are reduced to their hosts so that credentials are never exposed.
+//
+// Returns:
+//   - map[string]string: The "kind", "hosts" (comma-separated), and "service" labels
+func (c *OpenSearchConfig) Labels() map[string]string {
+	hosts := make([]string, 0, len(c.Addresses))
+	for _, address := range c.Addresses {
+		if u, err := url.Parse(address); err == nil {
+			hosts = append(hosts, u.Host)
+		}
+	}
+	return map[string]string{
+		"kind":    "opensearch",
+		"hosts":   strings.Join(hosts, ","),
+		"service": c.Service,
+	}
+}
+
+// LogValue implements slog.LogValuer, so that logging the configuration with log/slog
+// (e.g., slog.Info("config", "opensearch", cfg)) emits a group of its main fields.
+// The password, and any password embedded in the addresses, is redacted. Extra flags are not logged.
+//
+// Returns:
+//   - slog.Value: A group value holding the logged fields
+func (c *OpenSearchConfig) LogValue() slog.Value {
+	addresses := make([]string, 0, len(c.Addresses))
+	for _, address := range c.Addresses {
+		addresses = append(addresses, redactURL(address))
+	}
+	return slog.GroupValue(
+		slog.String("addresses", strings.Join(addresses, ",")),
+		slog.String("username", c.Username),
+		secretAttr("password", c.Password),
+		slog.Bool("sigv4", c.SigV4),
+		slog.String("sigv4_region", c.SigV4Region),
+		slog.String("service", c.Service),
+	)
+}
@@ -0,0 +1,205 @@
+package alex
+
+import "slices"
+
+// OpenSearchOption represents the configuration options for an OpenSearch client.
+// It includes the cluster addresses, the basic authentication credentials, and the AWS SigV4
+// request signing settings used by Amazon OpenSearch Service and OpenSearch Serverless.
+type OpenSearchOption struct {
+	Addresses   []string          // Addresses are the http:// or https:// URLs of the cluster nodes.
+	Username    string            // Username is the basic authentication username.
+	Password    string            // Password is the basic authentication password.
+	SigV4       bool              // SigV4 is a flag indicating whether requests are signed with AWS Signature Version 4.
+	SigV4Region string            // SigV4Region is the AWS region requests are signed for (required with SigV4).
+	Service     string            // Service is the AWS service requests are signed for: "es" or "aoss" for OpenSearch Serverless (required with SigV4).
+	Extra       map[string]string // Extra holds backend-specific flags without a dedicated field yet; unknown keys are preserved.
+	TrimSpace   bool              // TrimSpace is a flag indicating whether the constructor trims leading and trailing white space from the string fields.
+}
+
+// OpenSearchOptionBuilder provides a builder pattern for constructing OpenSearchOption.
+// It accumulates option functions that can be applied to configure an OpenSearchOption instance.
+// This builder implements the builderutil.Lister interface to work with the functional options pattern.
+type OpenSearchOptionBuilder struct {
+	Opts []func(*OpenSearchOption) error // Opts contains the list of option functions to be applied
+}
+
+// SetExtra sets a backend-specific flag that has no dedicated field yet, as a forward-compatible escape hatch.
+// It appends an option function that adds the key/value pair to the Extra field of OpenSearchOption.
+// The constructor does not interpret the flags but preserves them in the final configuration.
+//
+// Parameters:
+//   - key: The flag name (must not be empty); setting an existing key replaces its value
+//   - value: The flag value
+//
+// Returns:
+//   - *OpenSearchOptionBuilder: The builder instance for method chaining
+func (builder *OpenSearchOptionBuilder) SetExtra(key, value string) *OpenSearchOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *OpenSearchOption) error {
+		if args.Extra == nil {
+			args.Extra = make(map[string]string)
+		}
+		args.Extra[key] = value
+		return nil
+	})
+	return builder
+}
+
+// List returns the slice of option functions accumulated by the builder.
+// This method implements the builderutil.Lister interface, allowing the builder
+// to be used with the builderutil.Build function.
+// The functions are returned in the order the setters were called and builderutil.Build applies them
+// in that order, so when several setters touch the same field the last call wins. The returned slice
+// is a copy, so callers cannot reorder or drop the queued options.
+//
+// Returns:
+//   - []func(*OpenSearchOption) error: A slice of option functions that can be applied to configure OpenSearchOption
+func (builder *OpenSearchOptionBuilder) List() []func(*OpenSearchOption) error {
+	return slices.Clone(builder.Opts)
+}
+
+// NewOpenSearchOption creates and returns a new instance of OpenSearchOptionBuilder.
+// This function provides a convenient way to initialize the builder for creating OpenSearch configuration options.
+//
+// Returns:
+//   - *OpenSearchOptionBuilder: A new instance of OpenSearchOptionBuilder ready to be configured
+//
+// Example:
+//
+//	builder := NewOpenSearchOption()
+//	config, err := NewOpenSearchConfig(builder.AddAddress("https://search.example.com:9200").SetUsername("admin").SetPassword("secret"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("OpenSearch Config: %+v\n", config)
+func NewOpenSearchOption() *OpenSearchOptionBuilder {
+	return &OpenSearchOptionBuilder{}
+}
+
+// AddAddress adds the URL of a cluster node.
+// It appends an option function that appends the address to the Addresses field of OpenSearchOption.
+//
+// Parameters:
+//   - address: The http:// or https:// URL of the node (e.g., "https://search.example.com:9200")
+//
+// Returns:
+//   - *OpenSearchOptionBuilder: The builder instance for method chaining
+func (builder *OpenSearchOptionBuilder) AddAddress(address string) *OpenSearchOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *OpenSearchOption) error {
+		args.Addresses = append(args.Addresses, address)
+		return nil
+	})
+	return builder
+}
+
+// SetUsername configures the basic authentication username.
+// It appends an option function that sets the Username field of OpenSearchOption.
+//
+// Parameters:
+//   - username: The basic authentication username
+//
+// Returns:
+//   - *OpenSearchOptionBuilder: The builder instance for method chaining
+func (builder *OpenSearchOptionBuilder) SetUsername(username string) *OpenSearchOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *OpenSearchOption) error {
+		args.Username = username
+		return nil
+	})
+	return builder
+}
+
+// SetPassword configures the basic authentication password.
+// It appends an option function that sets the Password field of OpenSearchOption.
+// A "vault://path#key" reference is resolved at build time through the SecretResolver set with SetSecretResolver.
+//
+// Parameters:
+//   - password: The basic authentication password
+//
+// Returns:
+//   - *OpenSearchOptionBuilder: The builder instance for method chaining
+func (builder *OpenSearchOptionBuilder) SetPassword(password string) *OpenSearchOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *OpenSearchOption) error {
+		args.Password = password
+		return nil
+	})
+	return builder
+}
+
+// SetSigV4 configures whether requests are signed with AWS Signature Version 4.
+// It appends an option function that sets the SigV4 field of OpenSearchOption.
+// When enabled, a region and a service are required.
+//
+// Parameters:
+//   - sigV4: A flag indicating whether requests are signed with AWS SigV4
+//
+// Returns:
+//   - *OpenSearchOptionBuilder: The builder instance for method chaining
+func (builder *OpenSearchOptionBuilder) SetSigV4(sigV4 bool) *OpenSearchOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *OpenSearchOption) error {
+		args.SigV4 = sigV4
+		return nil
+	})
+	return builder
+}
+
+// SetSigV4Region configures the AWS region requests are signed for.
+// It appends an option function that sets the SigV4Region field of OpenSearchOption.
+//
+// Parameters:
+//   - region: The AWS region (e.g., "eu-west-1")
+//
+// Returns:
+//   - *OpenSearchOptionBuilder: The builder instance for method chaining
+func (builder *OpenSearchOptionBuilder) SetSigV4Region(region string) *OpenSearchOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *OpenSearchOption) error {
+		args.SigV4Region = region
+		return nil
+	})
+	return builder
+}
+
+// SetService configures the AWS service requests are signed for.
+// It appends an option function that sets the Service field of OpenSearchOption.
+//
+// Parameters:
+//   - service: The AWS service: "es" for Amazon OpenSearch Service, or "aoss" for OpenSearch Serverless
+//
+// Returns:
+//   - *OpenSearchOptionBuilder: The builder instance for method chaining
+func (builder *OpenSearchOptionBuilder) SetService(service string) *OpenSearchOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *OpenSearchOption) error {
+		args.Service = service
+		return nil
+	})
+	return builder
+}
+
+// SetTrimSpace configures whether leading and trailing white space is trimmed from the string fields before validation.
+// It appends an option function that sets the TrimSpace field of OpenSearchOption.
+// Trimming is off by default so that values are used exactly as given; enabling it guards against
+// a password or region copied with a trailing space silently breaking the configuration.
+//
+// Parameters:
+//   - trim: A flag indicating whether the string fields are trimmed
+//
+// Returns:
+//   - *OpenSearchOptionBuilder: The builder instance for method chaining
+func (builder *OpenSearchOptionBuilder) SetTrimSpace(trim bool) *OpenSearchOptionBuilder {
+	builder.Opts = append(builder.Opts, func(args *OpenSearchOption) error {
+		args.TrimSpace = trim
+		return nil
+	})
+	return builder
+}
+
+// OpenSearchConfig represents the final OpenSearch configuration used for establishing connections.
+// This struct is created from OpenSearchOption after validation and contains all the necessary
+// parameters for connecting to an OpenSearch cluster.
+type OpenSearchConfig struct {
+	Addresses   []string          // Addresses are the http:// or https:// URLs of the cluster nodes.
+	Username    string            // Username is the basic authentication username.
+	Password    string            // Password is the basic authentication password.
+	SigV4       bool              // SigV4 is a flag indicating whether requests are signed with AWS Signature Version 4.
+	SigV4Region string            // SigV4Region is the AWS region requests are signed for.
+	Service     string            // Service is the AWS service requests are signed for: "es" or "aoss" for OpenSearch Serverless.
+	Extra       map[string]string // Extra holds backend-specific flags without a dedicated field yet; unknown keys are preserved.
+}
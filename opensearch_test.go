@@ -0,0 +1,51 @@
+package alex
+
+import "testing"
+
+func TestNewOpenSearchConfigSigV4(t *testing.T) {
+	address := "https://abc123.eu-west-1.aoss.amazonaws.com"
+	tests := []struct {
+		name    string
+		builder *OpenSearchOptionBuilder
+		field   string
+	}{
+		{name: "missing_region", builder: NewOpenSearchOption().AddAddress(address).SetSigV4(true).SetService("aoss"), field: "SigV4Region"},
+		{name: "missing_service", builder: NewOpenSearchOption().AddAddress(address).SetSigV4(true).SetSigV4Region("eu-west-1"), field: "Service"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewOpenSearchConfig(tt.builder)
+			requireValidationError(t, err, tt.field, RuleRequired)
+		})
+	}
+
+	config, err := NewOpenSearchConfig(NewOpenSearchOption().AddAddress(address).SetSigV4(true).SetSigV4Region("eu-west-1").SetService("aoss"))
+	if err != nil {
+		t.Fatalf("NewOpenSearchConfig() error = %v", err)
+	}
+	if !config.SigV4 || config.SigV4Region != "eu-west-1" || config.Service != "aoss" {
+		t.Errorf("config = %+v, want SigV4 signing for aoss in eu-west-1", config)
+	}
+	if _, err := NewOpenSearchConfig(NewOpenSearchOption().AddAddress("http://localhost:9200").SetUsername("admin").SetPassword("admin")); err != nil {
+		t.Errorf("NewOpenSearchConfig() without SigV4 error = %v, want no region or service required", err)
+	}
+}
+
+func TestNewOpenSearchConfigService(t *testing.T) {
+	for _, service := range []string{"es", "aoss"} {
+		if _, err := NewOpenSearchConfig(NewOpenSearchOption().AddAddress("https://search.example.com").SetService(service)); err != nil {
+			t.Errorf("NewOpenSearchConfig(%q) error = %v", service, err)
+		}
+	}
+	for _, service := range []string{"elasticsearch", "ES", "s3"} {
+		_, err := NewOpenSearchConfig(NewOpenSearchOption().AddAddress("https://search.example.com").SetService(service))
+		requireValidationError(t, err, "Service", RuleFormat)
+	}
+}
+
+func TestNewOpenSearchConfigAddresses(t *testing.T) {
+	_, err := NewOpenSearchConfig(NewOpenSearchOption())
+	requireValidationError(t, err, "Addresses", RuleRequired)
+	_, err = NewOpenSearchConfig(NewOpenSearchOption().AddAddress("https://node1:9200").AddAddress("node2:9200"))
+	requireValidationError(t, err, "Addresses[1]", RuleFormat)
+}
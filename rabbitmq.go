@@ -0,0 +1,111 @@
+package alex
+
+import (
+	"fmt"
+	"log/slog"
+	"net/url"
+
+	"github.com/zeroxsolutions/strike/builderutil"
+)
+
+// NewRabbitMQConfig creates a new RabbitMQConfig from RabbitMQOptions by applying the provided option functions.
+// It uses the builderutil package to build the configuration options in a functional options pattern,
+// then creates and returns a final RabbitMQConfig instance.
+//
+// Validation rules:
+//   - URL is required and must use the "amqp" or "amqps" scheme with a host
+//   - Exchange type, when set, must be "direct", "fanout", "topic", or "headers"
+//   - Exchange is required when an exchange type is set
+//
+// Parameters:
+//   - opts: Variable number of option functions that configure the RabbitMQOptions
+//
+// Returns:
+//   - *RabbitMQConfig: A pointer to the final RabbitMQ configuration instance
+//   - error: An error if the configuration building process fails or validation fails
+//
+// Example:
+//
+//	builder := NewRabbitMQOptions()
+//	config, err := NewRabbitMQConfig(builder.SetURL("amqp://localhost:5672/").SetQueue("jobs"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewRabbitMQConfig(opts ...builderutil.Lister[RabbitMQOptions]) (*RabbitMQConfig, error) {
+	options, err := builderutil.Build(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if options.TrimSpace {
+		trimSpace(&options.URL, &options.Exchange, &options.ExchangeType, &options.Queue)
+	}
+	config := &RabbitMQConfig{
+		URL:          options.URL,
+		Exchange:     options.Exchange,
+		ExchangeType: options.ExchangeType,
+		Queue:        options.Queue,
+		Extra:        options.Extra,
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	notifyBuild(config)
+	return config, nil
+}
+
+// Validate checks that the RabbitMQConfig satisfies the rules enforced by its constructor.
+// It performs no I/O, so it can be used to re-check a configuration at any time.
+//
+// Returns:
+//   - error: A *ValidationError describing the first rule the configuration violates, or nil if it is valid
+func (c *RabbitMQConfig) Validate() error {
+	if c.URL == "" {
+		return newValidationError("URL", RuleRequired, "rabbitmq url is required")
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "amqp" && u.Scheme != "amqps") || u.Host == "" {
+		return newValidationError("URL", RuleFormat, "rabbitmq url must be an amqp:// or amqps:// URL with a host")
+	}
+	switch c.ExchangeType {
+	case "", "direct", "fanout", "topic", "headers":
+	default:
+		return newValidationError("ExchangeType", RuleFormat, fmt.Sprintf("rabbitmq exchange type %q must be direct, fanout, topic, or headers", c.ExchangeType))
+	}
+	if err := validateExtra(c.Extra, "rabbitmq"); err != nil {
+		return err
+	}
+	return requireIf(c.ExchangeType != "", c.Exchange, "Exchange", "rabbitmq exchange is required when an exchange type is set")
+}
+
+// Labels returns identifying, non-secret key/value pairs describing the configuration.
+// The URL is reduced to its host so that credentials are never exposed.
+//
+// Returns:
+//   - map[string]string: The "kind", "host", "exchange", and "queue" labels
+func (c *RabbitMQConfig) Labels() map[string]string {
+	host := ""
+	if u, err := url.Parse(c.URL); err == nil {
+		host = u.Host
+	}
+	return map[string]string{
+		"kind":     "rabbitmq",
+		"host":     host,
+		"exchange": c.Exchange,
+		"queue":    c.Queue,
+	}
+}
+
+// LogValue implements slog.LogValuer, so that logging the configuration with log/slog
+// (e.g., slog.Info("config", "rabbitmq", cfg)) emits a group of its main fields.
+// The password embedded in the URL is redacted. Extra flags are not logged.
+//
+// Returns:
+//   - slog.Value: A group value holding the logged fields
+func (c *RabbitMQConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("url", redactURL(c.URL)),
+		slog.String("exchange", c.Exchange),
+		slog.String("exchange_type", c.ExchangeType),
+		slog.String("queue", c.Queue),
+	)
+}
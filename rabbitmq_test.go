@@ -0,0 +1,53 @@
+package alex
+
+import "testing"
+
+func TestNewRabbitMQConfigExchangeType(t *testing.T) {
+	for _, exchangeType := range []string{"direct", "fanout", "topic", "headers"} {
+		t.Run(exchangeType, func(t *testing.T) {
+			config, err := NewRabbitMQConfig(NewRabbitMQOptions().
+				SetURL("amqp://localhost:5672/").
+				SetExchange("events").
+				SetExchangeType(exchangeType).
+				SetQueue("jobs"))
+			if err != nil {
+				t.Fatalf("NewRabbitMQConfig() error = %v", err)
+			}
+			if config.Exchange != "events" || config.ExchangeType != exchangeType || config.Queue != "jobs" {
+				t.Errorf("config = %q/%q/%q, want events/%s/jobs", config.Exchange, config.ExchangeType, config.Queue, exchangeType)
+			}
+		})
+	}
+	_, err := NewRabbitMQConfig(NewRabbitMQOptions().SetURL("amqp://localhost:5672/").SetExchange("events").SetExchangeType("x-delayed"))
+	requireValidationError(t, err, "ExchangeType", RuleFormat)
+}
+
+func TestNewRabbitMQConfigTypeRequiresExchange(t *testing.T) {
+	_, err := NewRabbitMQConfig(NewRabbitMQOptions().SetURL("amqp://localhost:5672/").SetExchangeType("topic"))
+	requireValidationError(t, err, "Exchange", RuleRequired)
+
+	if _, err := NewRabbitMQConfig(NewRabbitMQOptions().SetURL("amqp://localhost:5672/").SetQueue("jobs")); err != nil {
+		t.Errorf("NewRabbitMQConfig() without exchange error = %v", err)
+	}
+	if _, err := NewRabbitMQConfig(NewRabbitMQOptions().SetURL("amqp://localhost:5672/").SetExchange("events")); err != nil {
+		t.Errorf("NewRabbitMQConfig() with exchange but no type error = %v", err)
+	}
+}
+
+func TestNewRabbitMQConfigURL(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		rule string
+	}{
+		{name: "empty", url: "", rule: RuleRequired},
+		{name: "wrong_scheme", url: "http://localhost:5672/", rule: RuleFormat},
+		{name: "no_host", url: "amqp:///vhost", rule: RuleFormat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRabbitMQConfig(NewRabbitMQOptions().SetURL(tt.url))
+			requireValidationError(t, err, "URL", tt.rule)
+		})
+	}
+}
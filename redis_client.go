@@ -0,0 +1,144 @@
+package alex
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisOptions maps the configuration onto the go-redis client options, keeping the mapping in one place.
+// The read and pool timeouts fall back to TimeoutDefault seconds, the pool size of 0 keeps the go-redis default,
+// WarmupConns maps onto MinIdleConns so that the pool opens and keeps that many connections, and the retry
+// policy maps MaxAttempts (which includes the first attempt) onto MaxRetries and the base/max delays onto
+// the retry backoff bounds. The TLS configuration is cloned, with its ServerName overridden by TLSServerName
+// and its GetClientCertificate set to ClientCertificateFunc when set.
+// go-redis only issues SELECT for a non-zero DB, so SkipSelect (which requires DB 0) needs no mapping.
+// CommandTimeout, MaxPipelineLength, DefaultTxTimeout, and LazyConnect have no go-redis counterpart and
+// are left to the caller (see CommandContext).
+//
+// Returns:
+//   - *redis.Options: The go-redis options for the configuration
+//
+// Example:
+//
+//	client := redis.NewClient(config.RedisOptions())
+//	defer client.Close()
+func (c *RedisConfig) RedisOptions() *redis.Options {
+	opts := &redis.Options{
+		Network:         c.Network,
+		Addr:            c.Addr,
+		Username:        c.Username,
+		Password:        c.Password,
+		DB:              c.DB,
+		DialTimeout:     c.DialTimeout,
+		ReadTimeout:     c.ReadTimeout,
+		PoolSize:        c.PoolSize,
+		MinIdleConns:    c.WarmupConns,
+		PoolTimeout:     c.PoolTimeout,
+		MinRetryBackoff: c.BaseDelay,
+		MaxRetryBackoff: c.MaxDelay,
+	}
+	if opts.ReadTimeout <= 0 {
+		opts.ReadTimeout = TimeoutDefault * time.Second
+	}
+	if opts.PoolTimeout <= 0 {
+		opts.PoolTimeout = TimeoutDefault * time.Second
+	}
+	switch {
+	case c.MaxAttempts == 1:
+		opts.MaxRetries = -1
+	case c.MaxAttempts > 1:
+		opts.MaxRetries = c.MaxAttempts - 1
+	}
+	if c.TLSConfig != nil {
+		opts.TLSConfig = c.TLSConfig.Clone()
+		if c.TLSServerName != "" {
+			opts.TLSConfig.ServerName = c.TLSServerName
+		}
+		if c.ClientCertificateFunc != nil {
+			opts.TLSConfig.GetClientCertificate = c.ClientCertificateFunc
+		}
+	}
+	return opts
+}
+
+// WaitReady pings the Redis server until it answers, for services that may start before Redis does.
+// Each attempt is bounded by CommandContext, and up to attempts pings are made, waiting interval
+// between them. The client used for pinging is closed before returning.
+//
+// Parameters:
+//   - ctx: The context bounding the whole wait
+//   - attempts: The maximum number of pings (values below 1 ping once)
+//   - interval: The delay between two pings
+//
+// Returns:
+//   - error: nil once a ping succeeds, the context error if ctx is done first, or an error wrapping
+//     the last ping error once every attempt failed
+//
+// Example:
+//
+//	if err := config.WaitReady(ctx, 10, time.Second); err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *RedisConfig) WaitReady(ctx context.Context, attempts int, interval time.Duration) error {
+	client := redis.NewClient(c.RedisOptions())
+	defer client.Close()
+	attempts = max(attempts, 1)
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			timer := time.NewTimer(interval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+		pingCtx, cancel := c.CommandContext(ctx)
+		err = client.Ping(pingCtx).Err()
+		cancel()
+		if err == nil {
+			return nil
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+	}
+	return fmt.Errorf("redis %s not ready after %d attempts: %w", c.Addr, attempts, err)
+}
+
+// VerifyAuth connects to the server and sends a PING, so that wrong credentials fail fast at startup
+// instead of on the first command. The client authenticates with the configured username and password
+// while opening the connection, and the PING is bounded by CommandContext.
+// A NOAUTH or WRONGPASS reply (or the "invalid password" reply of older servers) is reported as an
+// "authentication failed" error wrapping the server reply; any other failure is returned wrapped as is.
+//
+// Parameters:
+//   - ctx: The context bounding the check
+//
+// Returns:
+//   - error: An error if the credentials are rejected or the server cannot be reached, or nil otherwise
+//
+// Example:
+//
+//	if err := config.VerifyAuth(ctx); err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *RedisConfig) VerifyAuth(ctx context.Context) error {
+	client := redis.NewClient(c.RedisOptions())
+	defer client.Close()
+	pingCtx, cancel := c.CommandContext(ctx)
+	defer cancel()
+	err := client.Ping(pingCtx).Err()
+	switch {
+	case err == nil:
+		return nil
+	case redis.HasErrorPrefix(err, "NOAUTH"), redis.HasErrorPrefix(err, "WRONGPASS"), redis.HasErrorPrefix(err, "invalid password"):
+		return fmt.Errorf("redis %s authentication failed: %w", c.Addr, err)
+	default:
+		return fmt.Errorf("redis %s auth check failed: %w", c.Addr, err)
+	}
+}
@@ -0,0 +1,393 @@
+package alex
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestRedisConfigRedisOptions(t *testing.T) {
+	config := &RedisConfig{
+		Network:       "tcp",
+		Addr:          "localhost:6379",
+		Username:      "app",
+		Password:      "secret",
+		DB:            3,
+		DialTimeout:   2 * time.Second,
+		ReadTimeout:   4 * time.Second,
+		PoolSize:      20,
+		WarmupConns:   5,
+		PoolTimeout:   6 * time.Second,
+		RetryPolicy:   RetryPolicy{MaxAttempts: 4, BaseDelay: 10 * time.Millisecond, MaxDelay: time.Second},
+		TLSConfig:     &tls.Config{ServerName: "redis.internal", MinVersion: tls.VersionTLS12},
+		TLSServerName: "cache.example.com",
+	}
+	got := config.RedisOptions()
+	want := &redis.Options{
+		Network:         "tcp",
+		Addr:            "localhost:6379",
+		Username:        "app",
+		Password:        "secret",
+		DB:              3,
+		DialTimeout:     2 * time.Second,
+		ReadTimeout:     4 * time.Second,
+		PoolSize:        20,
+		MinIdleConns:    5,
+		PoolTimeout:     6 * time.Second,
+		MaxRetries:      3,
+		MinRetryBackoff: 10 * time.Millisecond,
+		MaxRetryBackoff: time.Second,
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(redis.Options{}), cmpopts.IgnoreFields(redis.Options{}, "TLSConfig")); diff != "" {
+		t.Errorf("RedisOptions() mismatch (-want +got):\n%s", diff)
+	}
+	if got.TLSConfig == config.TLSConfig {
+		t.Error("TLSConfig is shared, want a clone")
+	}
+	if got.TLSConfig.ServerName != "cache.example.com" || got.TLSConfig.MinVersion != tls.VersionTLS12 {
+		t.Errorf("TLSConfig = ServerName %q MinVersion %x, want cache.example.com and TLS 1.2", got.TLSConfig.ServerName, got.TLSConfig.MinVersion)
+	}
+	if config.TLSConfig.ServerName != "redis.internal" {
+		t.Errorf("source TLSConfig.ServerName = %q, want it unchanged", config.TLSConfig.ServerName)
+	}
+}
+
+func TestRedisConfigRedisOptionsDefaults(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxAttempts int
+		wantRetries int
+	}{
+		{name: "sdk_default", maxAttempts: 0, wantRetries: 0},
+		{name: "single_attempt", maxAttempts: 1, wantRetries: -1},
+		{name: "two_attempts", maxAttempts: 2, wantRetries: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := (&RedisConfig{Addr: "localhost:6379", RetryPolicy: RetryPolicy{MaxAttempts: tt.maxAttempts}}).RedisOptions()
+			if opts.MaxRetries != tt.wantRetries {
+				t.Errorf("MaxRetries = %d, want %d", opts.MaxRetries, tt.wantRetries)
+			}
+			if opts.ReadTimeout != TimeoutDefault*time.Second || opts.PoolTimeout != TimeoutDefault*time.Second {
+				t.Errorf("ReadTimeout, PoolTimeout = %v, %v, want %v", opts.ReadTimeout, opts.PoolTimeout, TimeoutDefault*time.Second)
+			}
+			if opts.TLSConfig != nil {
+				t.Errorf("TLSConfig = %v, want nil without TLS", opts.TLSConfig)
+			}
+		})
+	}
+}
+
+func TestRedisConfigPoolTimeout(t *testing.T) {
+	config, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("localhost:6379").SetPoolSize(5).SetPoolTimeout(2 * time.Second))
+	if err != nil {
+		t.Fatalf("NewRedisConfig() error = %v", err)
+	}
+	if config.PoolTimeout != 2*time.Second || config.RedisOptions().PoolTimeout != 2*time.Second {
+		t.Errorf("PoolTimeout = %v, RedisOptions().PoolTimeout = %v, want 2s", config.PoolTimeout, config.RedisOptions().PoolTimeout)
+	}
+
+	config, err = NewRedisConfig(NewRedisConfigOptions().SetAddr("localhost:6379"))
+	if err != nil {
+		t.Fatalf("NewRedisConfig() error = %v", err)
+	}
+	if config.PoolTimeout != 0 || config.RedisOptions().PoolTimeout != TimeoutDefault*time.Second {
+		t.Errorf("PoolTimeout = %v, RedisOptions().PoolTimeout = %v, want 0 falling back to %v", config.PoolTimeout, config.RedisOptions().PoolTimeout, TimeoutDefault*time.Second)
+	}
+
+	_, err = NewRedisConfig(NewRedisConfigOptions().SetAddr("localhost:6379").SetPoolTimeout(-time.Second))
+	requireValidationError(t, err, "PoolTimeout", RuleMin)
+}
+
+func TestRedisConfigTLSServerName(t *testing.T) {
+	config, err := NewRedisConfig(NewRedisConfigOptions().
+		SetAddr("lb.internal:6380").
+		SetTLSConfigFunc(func() (*tls.Config, error) { return &tls.Config{MinVersion: tls.VersionTLS12}, nil }).
+		SetTLSServerName("redis.example.com"))
+	if err != nil {
+		t.Fatalf("NewRedisConfig() error = %v", err)
+	}
+	if got := config.RedisOptions().TLSConfig.ServerName; got != "redis.example.com" {
+		t.Errorf("TLSConfig.ServerName = %q, want the override redis.example.com", got)
+	}
+
+	_, err = NewRedisConfig(NewRedisConfigOptions().SetAddr("lb.internal:6380").SetTLSServerName("redis.example.com"))
+	requireValidationError(t, err, "TLSServerName", RuleConflict)
+}
+
+// newTestCertificate returns a self-signed ECDSA certificate valid for localhost and 127.0.0.1.
+func newTestCertificate(t *testing.T) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestRedisConfigClientCertificateFunc(t *testing.T) {
+	cert := newTestCertificate(t)
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAnyClientCert,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.(*tls.Conn).Handshake()
+	}()
+
+	calls := 0
+	config, err := NewRedisConfig(NewRedisConfigOptions().
+		SetAddr(listener.Addr().String()).
+		SetTLSConfigFunc(func() (*tls.Config, error) { return &tls.Config{InsecureSkipVerify: true}, nil }).
+		SetClientCertificateFunc(func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			calls++
+			return &cert, nil
+		}))
+	if err != nil {
+		t.Fatalf("NewRedisConfig() error = %v", err)
+	}
+	conn, err := tls.Dial("tcp", config.Addr, config.RedisOptions().TLSConfig)
+	if err != nil {
+		t.Fatalf("TLS handshake error = %v", err)
+	}
+	defer conn.Close()
+	if calls != 1 {
+		t.Errorf("ClientCertificateFunc called %d times, want once during the handshake", calls)
+	}
+}
+
+func TestRedisConfigSkipSelect(t *testing.T) {
+	config, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("twemproxy:22121").SetSkipSelect(true))
+	if err != nil {
+		t.Fatalf("NewRedisConfig() error = %v", err)
+	}
+	if !config.SkipSelect {
+		t.Error("SkipSelect = false, want true")
+	}
+	if db := config.RedisOptions().DB; db != 0 {
+		t.Errorf("RedisOptions().DB = %d, want 0 so that no SELECT is issued", db)
+	}
+
+	_, err = NewRedisConfig(NewRedisConfigOptions().SetAddr("twemproxy:22121").SetSkipSelect(true).SetDB(1))
+	requireValidationError(t, err, "SkipSelect", RuleConflict)
+}
+
+// newTestRedisServer starts a TCP server that drops its first drop connections and answers the
+// following ones with a minimal RESP server: PING gets PONG, HELLO is refused so that the client
+// falls back to RESP2, and every other command gets OK. A negative drop drops every connection.
+// When password is set, commands other than AUTH are refused until AUTH is sent with it.
+// It returns the server address and the number of connections accepted so far.
+func newTestRedisServer(t *testing.T, drop int32, password string) (string, *atomic.Int32) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	var accepted atomic.Int32
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			if n := accepted.Add(1); drop < 0 || n <= drop {
+				conn.Close()
+				continue
+			}
+			go serveTestRedisConn(conn, password)
+		}
+	}()
+	return listener.Addr().String(), &accepted
+}
+
+// serveTestRedisConn answers the RESP commands read from conn until it is closed.
+func serveTestRedisConn(conn net.Conn, password string) {
+	defer conn.Close()
+	authenticated := password == ""
+	r := bufio.NewReader(conn)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil || !strings.HasPrefix(line, "*") {
+			return
+		}
+		n, _ := strconv.Atoi(strings.TrimSpace(line[1:]))
+		args := make([]string, n)
+		for i := range args {
+			if _, err := r.ReadString('\n'); err != nil {
+				return
+			}
+			arg, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			args[i] = strings.TrimSpace(arg)
+		}
+		reply := "+OK\r\n"
+		switch {
+		case n == 0:
+		case strings.EqualFold(args[0], "AUTH"):
+			authenticated = password == "" || args[n-1] == password
+			if !authenticated {
+				reply = "-WRONGPASS invalid username-password pair or user is disabled.\r\n"
+			}
+		case strings.EqualFold(args[0], "HELLO"):
+			reply = "-ERR unknown command 'HELLO'\r\n"
+		case !authenticated:
+			reply = "-NOAUTH Authentication required.\r\n"
+		case strings.EqualFold(args[0], "PING"):
+			reply = "+PONG\r\n"
+		}
+		if _, err := io.WriteString(conn, reply); err != nil {
+			return
+		}
+	}
+}
+
+func TestRedisConfigWaitReady(t *testing.T) {
+	addr, accepted := newTestRedisServer(t, 2, "")
+	config, err := NewRedisConfig(NewRedisConfigOptions().SetAddr(addr).SetRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := config.WaitReady(context.Background(), 5, 10*time.Millisecond); err != nil {
+		t.Fatalf("WaitReady() error = %v, want the third attempt to succeed", err)
+	}
+	if got := accepted.Load(); got != 3 {
+		t.Errorf("WaitReady() made %d connections, want 3", got)
+	}
+}
+
+func TestRedisConfigWaitReadyExhaustsAttempts(t *testing.T) {
+	// Every connection is dropped, so each ping fails without waiting for a timeout.
+	addr, accepted := newTestRedisServer(t, -1, "")
+	config, err := NewRedisConfig(NewRedisConfigOptions().SetAddr(addr).SetRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = config.WaitReady(context.Background(), 3, 10*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "after 3 attempts") {
+		t.Fatalf("WaitReady() error = %v, want an error after 3 attempts", err)
+	}
+	if got := accepted.Load(); got != 3 {
+		t.Errorf("WaitReady() made %d connections, want 3", got)
+	}
+}
+
+func TestRedisConfigWaitReadyContextDone(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+	config, err := NewRedisConfig(NewRedisConfigOptions().SetAddr(addr).SetRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := config.WaitReady(ctx, 1000, 10*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitReady() error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("WaitReady() returned after %v, want it to stop when the context is done", elapsed)
+	}
+}
+
+func TestRedisConfigWaitReadyIntegration(t *testing.T) {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		t.Skip("REDIS_ADDR is not set")
+	}
+	config, err := NewRedisConfig(NewRedisConfigOptions().SetAddr(addr).SetPassword(os.Getenv("REDIS_PASSWORD")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := config.WaitReady(context.Background(), 5, time.Second); err != nil {
+		t.Errorf("WaitReady() error = %v", err)
+	}
+}
+
+func TestRedisConfigVerifyAuth(t *testing.T) {
+	addr, _ := newTestRedisServer(t, 0, "s3cret")
+	tests := []struct {
+		name     string
+		password string
+		wantErr  string
+	}{
+		{name: "correct_password", password: "s3cret"},
+		{name: "wrong_password", password: "wrong", wantErr: "authentication failed"},
+		{name: "no_password", wantErr: "authentication failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := NewRedisConfig(NewRedisConfigOptions().SetAddr(addr).SetPassword(tt.password).SetRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = config.VerifyAuth(context.Background())
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("VerifyAuth() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("VerifyAuth() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	unreachable, _ := newTestRedisServer(t, -1, "")
+	config, err := NewRedisConfig(NewRedisConfigOptions().SetAddr(unreachable).SetRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := config.VerifyAuth(context.Background()); err == nil || strings.Contains(err.Error(), "authentication failed") {
+		t.Errorf("VerifyAuth() error = %v, want a connection error not reported as an authentication failure", err)
+	}
+}
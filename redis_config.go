@@ -1,20 +1,60 @@
 package alex
 
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zeroxsolutions/strike/builderutil"
+)
+
 // RedisConfigOptions holds the configuration options for connecting to a Redis cache system.
 // It includes the address of the Redis server, an optional password for authentication,
 // and the database number to select within the Redis instance.
 // This struct is used as input for building the final RedisConfig.
 type RedisConfigOptions struct {
-	Addr     string // Addr is the address of the Redis server (e.g., "localhost:6379").
-	Password string // Password is the optional authentication password for the Redis server.
-	DB       int    // DB is the database number to be selected within the Redis instance (default is 0).
+	Addr                  string                                                      // Addr is the address of the Redis server (e.g., "localhost:6379").
+	Username              string                                                      // Username is the optional ACL username for the Redis server.
+	Password              string                                                      // Password is the optional authentication password for the Redis server.
+	DB                    int                                                         // DB is the database number to be selected within the Redis instance (default is 0).
+	TLSConfig             *tls.Config                                                 // TLSConfig is the TLS configuration used for the connection (nil disables TLS).
+	MaxPipelineLength     int                                                         // MaxPipelineLength is the maximum number of commands batched in a single pipeline (0 means no limit).
+	DefaultTxTimeout      time.Duration                                               // DefaultTxTimeout is the default timeout applied to transactions (0 means no default).
+	ReadTimeout           time.Duration                                               // ReadTimeout is the timeout for reading a command reply (0 falls back to TimeoutDefault seconds).
+	Enabled               *bool                                                       // Enabled is a flag indicating whether the Redis backend is used (nil means enabled).
+	PoolSize              int                                                         // PoolSize is the maximum number of connections in the pool (0 uses the client default).
+	WarmupConns           int                                                         // WarmupConns is the number of connections the client layer pre-opens at startup (0 means no warmup).
+	RetryPolicy                                                                       // RetryPolicy is the retry and backoff policy for Redis commands.
+	TLSServerName         string                                                      // TLSServerName overrides the server name verified against the certificate when TLS is enabled.
+	ClientCertificateFunc func(*tls.CertificateRequestInfo) (*tls.Certificate, error) // ClientCertificateFunc supplies the client certificate on each TLS handshake, supporting rotated mTLS certificates.
+	LazyConnect           bool                                                        // LazyConnect is a flag telling the client layer to skip the eager connection check at startup.
+	CommandTimeout        time.Duration                                               // CommandTimeout bounds individual commands through CommandContext (0 falls back to TimeoutDefault seconds).
+	SkipSelect            bool                                                        // SkipSelect is a flag guaranteeing that no SELECT is issued, for proxies without SELECT support (requires DB 0).
+	PasswordFile          string                                                      // PasswordFile is the path of a file holding the password, read at build time (conflicts with Password).
+	Extra                 map[string]string                                           // Extra holds backend-specific flags without a dedicated field yet; unknown keys are preserved.
+	Network               string                                                      // Network is the network type: "tcp" (default) or "unix", in which case Addr is a socket path.
+	DialTimeout           time.Duration                                               // DialTimeout is the timeout for establishing new connections (0 uses the go-redis default).
+	PoolTimeout           time.Duration                                               // PoolTimeout is how long a command waits for a free pooled connection before failing (0 falls back to TimeoutDefault seconds).
+	*TLSOptions                                                                       // TLSOptions is the TLS material the TLS configuration was loaded from with SetTLSOptions (nil otherwise).
+	TrimSpace             bool                                                        // TrimSpace is a flag indicating whether the constructor trims leading and trailing white space from the string fields.
 }
 
 // RedisConfigOptionsBuilder provides a builder pattern for constructing RedisConfigOptions.
 // It accumulates option functions that can be applied to configure a RedisConfigOptions instance.
 // This builder implements the builderutil.Lister interface to work with the functional options pattern.
 type RedisConfigOptionsBuilder struct {
-	Opts []func(*RedisConfigOptions) error // Opts contains the list of option functions to be applied
+	Opts  []func(*RedisConfigOptions) error // Opts contains the list of option functions to be applied
+	names []string                          // names records the setter name of each option queued through the builder methods
+}
+
+// add appends an option function to the builder and records the name of the setter that queued it.
+func (b *RedisConfigOptionsBuilder) add(name string, fn func(*RedisConfigOptions) error) *RedisConfigOptionsBuilder {
+	b.Opts = append(b.Opts, fn)
+	b.names = append(b.names, name)
+	return b
 }
 
 // SetAddr configures the Redis server address for the connection.
@@ -26,15 +66,15 @@ type RedisConfigOptionsBuilder struct {
 // Returns:
 //   - *RedisConfigOptionsBuilder: The builder instance for method chaining
 func (b *RedisConfigOptionsBuilder) SetAddr(addr string) *RedisConfigOptionsBuilder {
-	b.Opts = append(b.Opts, func(o *RedisConfigOptions) error {
+	return b.add("SetAddr", func(o *RedisConfigOptions) error {
 		o.Addr = addr
 		return nil
 	})
-	return b
 }
 
 // SetPassword configures the authentication password for the Redis connection.
 // It appends an option function that sets the Password field of RedisConfigOptions.
+// A "vault://path#key" reference is resolved at build time through the SecretResolver set with SetSecretResolver.
 //
 // Parameters:
 //   - password: The authentication password for the Redis server
@@ -42,11 +82,10 @@ func (b *RedisConfigOptionsBuilder) SetAddr(addr string) *RedisConfigOptionsBuil
 // Returns:
 //   - *RedisConfigOptionsBuilder: The builder instance for method chaining
 func (b *RedisConfigOptionsBuilder) SetPassword(password string) *RedisConfigOptionsBuilder {
-	b.Opts = append(b.Opts, func(o *RedisConfigOptions) error {
+	return b.add("SetPassword", func(o *RedisConfigOptions) error {
 		o.Password = password
 		return nil
 	})
-	return b
 }
 
 // SetDB configures the Redis database number to select.
@@ -59,21 +98,542 @@ func (b *RedisConfigOptionsBuilder) SetPassword(password string) *RedisConfigOpt
 // Returns:
 //   - *RedisConfigOptionsBuilder: The builder instance for method chaining
 func (b *RedisConfigOptionsBuilder) SetDB(db int) *RedisConfigOptionsBuilder {
-	b.Opts = append(b.Opts, func(o *RedisConfigOptions) error {
+	return b.add("SetDB", func(o *RedisConfigOptions) error {
 		o.DB = db
 		return nil
 	})
+}
+
+// SetReadTimeout configures the timeout for reading a command reply.
+// It appends an option function that sets the ReadTimeout field of RedisConfigOptions.
+//
+// Parameters:
+//   - readTimeout: The read timeout (0 falls back to TimeoutDefault seconds)
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetReadTimeout(readTimeout time.Duration) *RedisConfigOptionsBuilder {
+	return b.add("SetReadTimeout", func(o *RedisConfigOptions) error {
+		o.ReadTimeout = readTimeout
+		return nil
+	})
+}
+
+// SetMaxPipelineLength configures the maximum number of commands batched in a single pipeline.
+// It appends an option function that sets the MaxPipelineLength field of RedisConfigOptions.
+// The value is informational: it does not change connection behavior and is consumed by repository layers.
+//
+// Parameters:
+//   - maxPipelineLength: The maximum pipeline length (0 means no limit)
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetMaxPipelineLength(maxPipelineLength int) *RedisConfigOptionsBuilder {
+	return b.add("SetMaxPipelineLength", func(o *RedisConfigOptions) error {
+		o.MaxPipelineLength = maxPipelineLength
+		return nil
+	})
+}
+
+// SetDefaultTxTimeout configures the default timeout applied to transactions.
+// It appends an option function that sets the DefaultTxTimeout field of RedisConfigOptions.
+// The value is informational: it does not change connection behavior and is consumed by repository layers.
+//
+// Parameters:
+//   - defaultTxTimeout: The default transaction timeout (0 means no default)
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetDefaultTxTimeout(defaultTxTimeout time.Duration) *RedisConfigOptionsBuilder {
+	return b.add("SetDefaultTxTimeout", func(o *RedisConfigOptions) error {
+		o.DefaultTxTimeout = defaultTxTimeout
+		return nil
+	})
+}
+
+// SetUsername configures the ACL username for the Redis connection.
+// It appends an option function that sets the Username field of RedisConfigOptions.
+//
+// Parameters:
+//   - username: The ACL username for the Redis server
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetUsername(username string) *RedisConfigOptionsBuilder {
+	return b.add("SetUsername", func(o *RedisConfigOptions) error {
+		o.Username = username
+		return nil
+	})
+}
+
+// SetPoolSize configures the maximum number of connections in the Redis connection pool.
+// It appends an option function that sets the PoolSize field of RedisConfigOptions.
+//
+// Parameters:
+//   - poolSize: The maximum number of connections in the pool (0 uses the client default)
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetPoolSize(poolSize int) *RedisConfigOptionsBuilder {
+	return b.add("SetPoolSize", func(o *RedisConfigOptions) error {
+		o.PoolSize = poolSize
+		return nil
+	})
+}
+
+// SetWarmupConns configures the number of connections the client layer pre-opens at startup.
+// It appends an option function that sets the WarmupConns field of RedisConfigOptions.
+// When PoolSize is set, the warmup count cannot exceed it.
+//
+// Parameters:
+//   - warmupConns: The number of connections to pre-open (0 means no warmup)
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetWarmupConns(warmupConns int) *RedisConfigOptionsBuilder {
+	return b.add("SetWarmupConns", func(o *RedisConfigOptions) error {
+		o.WarmupConns = warmupConns
+		return nil
+	})
+}
+
+// SetRetryPolicy configures the retry and backoff policy for Redis commands.
+// It appends an option function that sets the embedded RetryPolicy of RedisConfigOptions.
+//
+// Parameters:
+//   - retryPolicy: The retry and backoff policy
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetRetryPolicy(retryPolicy RetryPolicy) *RedisConfigOptionsBuilder {
+	return b.add("SetRetryPolicy", func(o *RedisConfigOptions) error {
+		o.RetryPolicy = retryPolicy
+		return nil
+	})
+}
+
+// SetTLSServerName configures the server name verified against the Redis server certificate.
+// It appends an option function that sets the TLSServerName field of RedisConfigOptions.
+// This is useful when connecting through a load balancer whose dial host differs from the certificate name.
+// It requires TLS to be enabled with SetTLSConfigFunc.
+//
+// Parameters:
+//   - serverName: The server name expected in the certificate (e.g., "redis.internal.example.com")
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetTLSServerName(serverName string) *RedisConfigOptionsBuilder {
+	return b.add("SetTLSServerName", func(o *RedisConfigOptions) error {
+		o.TLSServerName = serverName
+		return nil
+	})
+}
+
+// SetClientCertificateFunc configures a callback supplying the client certificate on each TLS handshake.
+// It appends an option function that sets the ClientCertificateFunc field of RedisConfigOptions.
+// The callback is installed as GetClientCertificate on the TLS configuration and takes precedence over
+// static certificates, so frequently rotated mTLS certificates are picked up without rebuilding the configuration.
+// It requires TLS to be enabled with SetTLSConfigFunc.
+//
+// Parameters:
+//   - fn: The function returning the client certificate for a handshake
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetClientCertificateFunc(fn func(*tls.CertificateRequestInfo) (*tls.Certificate, error)) *RedisConfigOptionsBuilder {
+	return b.add("SetClientCertificateFunc", func(o *RedisConfigOptions) error {
+		o.ClientCertificateFunc = fn
+		return nil
+	})
+}
+
+// SetLazyConnect configures whether the client layer defers connecting to Redis until first use.
+// It appends an option function that sets the LazyConnect field of RedisConfigOptions.
+// A lazy connection speeds up startup and lets a service boot while Redis is unavailable, at the cost
+// of deferring connection failures (bad address, credentials, or TLS) to the first command instead of startup.
+// The flag does not affect validation.
+//
+// Parameters:
+//   - lazy: A flag indicating whether the connection is deferred until first use
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetLazyConnect(lazy bool) *RedisConfigOptionsBuilder {
+	return b.add("SetLazyConnect", func(o *RedisConfigOptions) error {
+		o.LazyConnect = lazy
+		return nil
+	})
+}
+
+// SetCommandTimeout configures the logical timeout bounding individual Redis commands.
+// It appends an option function that sets the CommandTimeout field of RedisConfigOptions.
+// Unlike the dial and read timeouts, it is applied by the repository layer through CommandContext.
+//
+// Parameters:
+//   - d: The logical timeout of a single command (must be greater than or equal to 0)
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetCommandTimeout(d time.Duration) *RedisConfigOptionsBuilder {
+	return b.add("SetCommandTimeout", func(o *RedisConfigOptions) error {
+		o.CommandTimeout = d
+		return nil
+	})
+}
+
+// SetSkipSelect configures whether the client must never issue a SELECT command, for proxies such as Twemproxy that reject it.
+// It appends an option function that sets the SkipSelect field of RedisConfigOptions.
+// It is only meaningful with database 0, which RedisOptions connects to without a SELECT; any other database is rejected.
+//
+// Parameters:
+//   - skip: A flag indicating whether the database SELECT must never be issued
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetSkipSelect(skip bool) *RedisConfigOptionsBuilder {
+	return b.add("SetSkipSelect", func(o *RedisConfigOptions) error {
+		o.SkipSelect = skip
+		return nil
+	})
+}
+
+// SetPasswordFile configures a file holding the authentication password for the Redis connection.
+// It appends an option function that sets the PasswordFile field of RedisConfigOptions.
+// The file is read by NewRedisConfig, ignoring a trailing newline; setting both a password and a password file is an error.
+//
+// Parameters:
+//   - path: The path of the file holding the password (e.g., a mounted Kubernetes secret)
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetPasswordFile(path string) *RedisConfigOptionsBuilder {
+	return b.add("SetPasswordFile", func(o *RedisConfigOptions) error {
+		o.PasswordFile = path
+		return nil
+	})
+}
+
+// SetNetwork configures the network type used to reach the Redis server.
+// It appends an option function that sets the Network field of RedisConfigOptions.
+// With "unix", Addr is the filesystem path of the server socket (e.g., "/var/run/redis/redis.sock")
+// instead of a "host:port" address. NewRedisConfig defaults the network to "tcp".
+//
+// Parameters:
+//   - network: The network type: "tcp" or "unix"
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetNetwork(network string) *RedisConfigOptionsBuilder {
+	return b.add("SetNetwork", func(o *RedisConfigOptions) error {
+		o.Network = network
+		return nil
+	})
+}
+
+// SetDialTimeout configures the timeout for establishing new connections to the Redis server.
+// It appends an option function that sets the DialTimeout field of RedisConfigOptions.
+//
+// Parameters:
+//   - d: The timeout for establishing new connections (0 uses the go-redis default)
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetDialTimeout(d time.Duration) *RedisConfigOptionsBuilder {
+	return b.add("SetDialTimeout", func(o *RedisConfigOptions) error {
+		o.DialTimeout = d
+		return nil
+	})
+}
+
+// SetPoolTimeout configures how long a command waits for a free connection when all PoolSize connections are in use.
+// It appends an option function that sets the PoolTimeout field of RedisConfigOptions.
+// Together with PoolSize it caps the active connections and applies backpressure under load.
+//
+// Parameters:
+//   - d: The time to wait for a free connection (0 falls back to TimeoutDefault seconds)
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetPoolTimeout(d time.Duration) *RedisConfigOptionsBuilder {
+	return b.add("SetPoolTimeout", func(o *RedisConfigOptions) error {
+		o.PoolTimeout = d
+		return nil
+	})
+}
+
+// SetTrimSpace configures whether leading and trailing white space is trimmed from the string fields before validation.
+// It appends an option function that sets the TrimSpace field of RedisConfigOptions.
+// Trimming is off by default so that values are used exactly as given; enabling it guards against
+// a password pasted with a trailing newline silently breaking the configuration.
+//
+// Parameters:
+//   - trim: A flag indicating whether the string fields are trimmed
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetTrimSpace(trim bool) *RedisConfigOptionsBuilder {
+	return b.add("SetTrimSpace", func(o *RedisConfigOptions) error {
+		o.TrimSpace = trim
+		return nil
+	})
+}
+
+// SetEnabled configures whether the Redis backend is enabled.
+// It appends an option function that sets the Enabled field of RedisConfigOptions.
+// Backends are enabled by default; a disabled backend skips validation, so none of its fields are required.
+//
+// Parameters:
+//   - enabled: A flag indicating whether the Redis backend is used
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetEnabled(enabled bool) *RedisConfigOptionsBuilder {
+	return b.add("SetEnabled", func(o *RedisConfigOptions) error {
+		o.Enabled = &enabled
+		return nil
+	})
+}
+
+// SetTLSConfigFunc configures a function assembling the TLS configuration for the Redis connection.
+// It appends an option function that invokes fn at build time and sets the TLSConfig field of RedisConfigOptions,
+// which supports dynamic certificate loading (e.g., SPIFFE). An error returned by fn is surfaced by NewRedisConfig.
+//
+// Parameters:
+//   - fn: The function returning the TLS configuration to use
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetTLSConfigFunc(fn func() (*tls.Config, error)) *RedisConfigOptionsBuilder {
+	return b.add("SetTLSConfigFunc", func(o *RedisConfigOptions) error {
+		if fn == nil {
+			return errors.New("redis tls config func is nil")
+		}
+		tlsConfig, err := fn()
+		if err != nil {
+			return fmt.Errorf("redis tls config func failed: %w", err)
+		}
+		o.TLSConfig = tlsConfig
+		o.TLSOptions = nil
+		return nil
+	})
+}
+
+// SetTLSOptions configures the TLS connection from certificate, key, and CA files.
+// It appends an option function that builds the TLS options at build time, loads the material with
+// TLSOptions.NewTLSConfig, and sets both the TLSOptions and TLSConfig fields of RedisConfigOptions.
+// It replaces a TLS configuration set with SetTLSConfigFunc, and the other way around.
+//
+// Parameters:
+//   - opts: The option listers configuring the TLS material (typically a TLSOptionsBuilder)
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewRedisConfigOptions().SetAddr("redis.internal:6380").
+//	    SetTLSOptions(NewTLSOptions().SetCAFile("/etc/tls/ca.crt"))
+func (b *RedisConfigOptionsBuilder) SetTLSOptions(opts ...builderutil.Lister[TLSOptions]) *RedisConfigOptionsBuilder {
+	return b.add("SetTLSOptions", func(o *RedisConfigOptions) error {
+		tlsOptions, err := builderutil.Build(opts...)
+		if err != nil {
+			return err
+		}
+		tlsConfig, err := tlsOptions.NewTLSConfig()
+		if err != nil {
+			return nestValidationError(err, "TLSOptions", "redis")
+		}
+		o.TLSConfig = tlsConfig
+		o.TLSOptions = tlsOptions
+		return nil
+	})
+}
+
+// When applies fn to the builder only if cond is true, enabling fluent conditional chains
+// instead of separate if-blocks around setter calls.
+//
+// Parameters:
+//   - cond: The condition deciding whether fn is applied
+//   - fn: The function configuring the builder when cond is true
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewRedisConfigOptions().
+//	    SetAddr("localhost:6379").
+//	    When(password != "", func(b *RedisConfigOptionsBuilder) { b.SetPassword(password) })
+func (b *RedisConfigOptionsBuilder) When(cond bool, fn func(*RedisConfigOptionsBuilder)) *RedisConfigOptionsBuilder {
+	if cond && fn != nil {
+		fn(b)
+	}
 	return b
 }
 
+// From seeds the builder from an already populated RedisConfigOptions.
+// It enqueues the matching setter for each non-zero field of the given options,
+// which eases migration from manual struct construction to the builder.
+//
+// Parameters:
+//   - o: The options whose non-zero fields should be applied
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+//
+// Example:
+//
+//	builder := NewRedisConfigOptions()
+//	config, err := NewRedisConfig(builder.From(RedisConfigOptions{Addr: "localhost:6379", DB: 1}))
+func (b *RedisConfigOptionsBuilder) From(o RedisConfigOptions) *RedisConfigOptionsBuilder {
+	if o.Addr != "" {
+		b.SetAddr(o.Addr)
+	}
+	if o.Password != "" {
+		b.SetPassword(o.Password)
+	}
+	if o.DB != 0 {
+		b.SetDB(o.DB)
+	}
+	if o.ReadTimeout != 0 {
+		b.SetReadTimeout(o.ReadTimeout)
+	}
+	if o.MaxPipelineLength != 0 {
+		b.SetMaxPipelineLength(o.MaxPipelineLength)
+	}
+	if o.DefaultTxTimeout != 0 {
+		b.SetDefaultTxTimeout(o.DefaultTxTimeout)
+	}
+	if o.Username != "" {
+		b.SetUsername(o.Username)
+	}
+	if o.PoolSize != 0 {
+		b.SetPoolSize(o.PoolSize)
+	}
+	if o.WarmupConns != 0 {
+		b.SetWarmupConns(o.WarmupConns)
+	}
+	if o.RetryPolicy != (RetryPolicy{}) {
+		b.SetRetryPolicy(o.RetryPolicy)
+	}
+	if o.TLSServerName != "" {
+		b.SetTLSServerName(o.TLSServerName)
+	}
+	if o.ClientCertificateFunc != nil {
+		b.SetClientCertificateFunc(o.ClientCertificateFunc)
+	}
+	if o.LazyConnect {
+		b.SetLazyConnect(o.LazyConnect)
+	}
+	if o.CommandTimeout != 0 {
+		b.SetCommandTimeout(o.CommandTimeout)
+	}
+	if o.SkipSelect {
+		b.SetSkipSelect(o.SkipSelect)
+	}
+	if o.PasswordFile != "" {
+		b.SetPasswordFile(o.PasswordFile)
+	}
+	for key, value := range o.Extra {
+		b.SetExtra(key, value)
+	}
+	if o.Network != "" {
+		b.SetNetwork(o.Network)
+	}
+	if o.DialTimeout != 0 {
+		b.SetDialTimeout(o.DialTimeout)
+	}
+	if o.PoolTimeout != 0 {
+		b.SetPoolTimeout(o.PoolTimeout)
+	}
+	if o.TrimSpace {
+		b.SetTrimSpace(o.TrimSpace)
+	}
+	if o.Enabled != nil {
+		b.SetEnabled(*o.Enabled)
+	}
+	if o.TLSOptions != nil {
+		b.SetTLSOptions(NewTLSOptions().From(*o.TLSOptions))
+	} else if o.TLSConfig != nil {
+		tlsConfig := o.TLSConfig
+		b.SetTLSConfigFunc(func() (*tls.Config, error) { return tlsConfig, nil })
+	}
+	return b
+}
+
+// Describe returns a short description of the options queued on the builder, for use in error messages.
+// It reports the number of queued options and, when every option was queued through the builder
+// methods, the names of the setters in the order they were called.
+//
+// Returns:
+//   - string: A description such as "2 options: SetAddr, SetDB"
+//
+// Example:
+//
+//	builder := NewRedisConfigOptions().SetAddr("localhost:6379").SetDB(1)
+//	fmt.Println(builder.Describe()) // 2 options: SetAddr, SetDB
+func (b *RedisConfigOptionsBuilder) Describe() string {
+	desc := fmt.Sprintf("%d options", len(b.Opts))
+	if len(b.Opts) == 1 {
+		desc = "1 option"
+	}
+	if len(b.names) == 0 || len(b.names) != len(b.Opts) {
+		return desc
+	}
+	return desc + ": " + strings.Join(b.names, ", ")
+}
+
+// SetExtra sets a backend-specific flag that has no dedicated field yet, as a forward-compatible escape hatch.
+// It appends an option function that adds the key/value pair to the Extra field of RedisConfigOptions.
+// The constructor does not interpret the flags but preserves them in the final configuration.
+//
+// Parameters:
+//   - key: The flag name (must not be empty); setting an existing key replaces its value
+//   - value: The flag value
+//
+// Returns:
+//   - *RedisConfigOptionsBuilder: The builder instance for method chaining
+func (b *RedisConfigOptionsBuilder) SetExtra(key, value string) *RedisConfigOptionsBuilder {
+	return b.add("SetExtra", func(o *RedisConfigOptions) error {
+		if o.Extra == nil {
+			o.Extra = make(map[string]string)
+		}
+		o.Extra[key] = value
+		return nil
+	})
+}
+
 // List returns the slice of option functions accumulated by the builder.
 // This method implements the builderutil.Lister interface, allowing the builder
 // to be used with the builderutil.Build function.
+// The functions are returned in the order the setters were called and builderutil.Build applies them
+// in that order, so when several setters touch the same field the last call wins. The returned slice
+// is a copy, so callers cannot reorder or drop the queued options.
 //
 // Returns:
 //   - []func(*RedisConfigOptions) error: A slice of option functions that can be applied to configure RedisConfigOptions
 func (b *RedisConfigOptionsBuilder) List() []func(*RedisConfigOptions) error {
-	return b.Opts
+	return slices.Clone(b.Opts)
+}
+
+// Build applies the accumulated option functions and returns the raw RedisConfigOptions,
+// without the validation performed by NewRedisConfig. It is intended for inspecting the
+// assembled options (e.g., in tests); use NewRedisConfig to obtain a usable configuration.
+// Secret references and the password file are left unresolved; they are resolved by the constructor.
+//
+// Returns:
+//   - *RedisConfigOptions: The options assembled from the builder
+//   - error: An error if any option function fails
+//
+// Example:
+//
+//	options, err := NewRedisConfigOptions().SetAddr("localhost:6379").Build()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Redis Options: %+v\n", options)
+func (b *RedisConfigOptionsBuilder) Build() (*RedisConfigOptions, error) {
+	return builderutil.Build[RedisConfigOptions](b)
 }
 
 // NewRedisConfigOptions creates and returns a new instance of RedisConfigOptionsBuilder.
@@ -94,7 +654,37 @@ func NewRedisConfigOptions() *RedisConfigOptionsBuilder {
 // This struct is created from RedisConfigOptions after validation and contains all the necessary
 // parameters for connecting to a Redis server.
 type RedisConfig struct {
-	Addr     string // Addr is the address of the Redis server (e.g., "localhost:6379").
-	Password string // Password is the optional authentication password for the Redis server.
-	DB       int    // DB is the database number to be selected within the Redis instance (default is 0).
+	Addr                  string                                                      // Addr is the address of the Redis server (e.g., "localhost:6379").
+	Username              string                                                      // Username is the optional ACL username for the Redis server.
+	Password              string                                                      // Password is the optional authentication password for the Redis server.
+	DB                    int                                                         // DB is the database number to be selected within the Redis instance (default is 0).
+	TLSConfig             *tls.Config                                                 // TLSConfig is the TLS configuration used for the connection (nil disables TLS).
+	MaxPipelineLength     int                                                         // MaxPipelineLength is the maximum number of commands batched in a single pipeline (0 means no limit).
+	DefaultTxTimeout      time.Duration                                               // DefaultTxTimeout is the default timeout applied to transactions (0 means no default).
+	ReadTimeout           time.Duration                                               // ReadTimeout is the timeout for reading a command reply (NewRedisConfig stores TimeoutDefault seconds when unset).
+	Enabled               bool                                                        // Enabled is a flag indicating whether the Redis backend is used.
+	PoolSize              int                                                         // PoolSize is the maximum number of connections in the pool (0 uses the client default).
+	WarmupConns           int                                                         // WarmupConns is the number of connections the client layer pre-opens at startup (0 means no warmup).
+	RetryPolicy                                                                       // RetryPolicy is the retry and backoff policy for Redis commands.
+	TLSServerName         string                                                      // TLSServerName overrides the server name verified against the certificate when TLS is enabled.
+	ClientCertificateFunc func(*tls.CertificateRequestInfo) (*tls.Certificate, error) // ClientCertificateFunc supplies the client certificate on each TLS handshake, supporting rotated mTLS certificates.
+	LazyConnect           bool                                                        // LazyConnect is a flag telling the client layer to skip the eager connection check at startup.
+	CommandTimeout        time.Duration                                               // CommandTimeout bounds individual commands through CommandContext (0 falls back to TimeoutDefault seconds).
+	SkipSelect            bool                                                        // SkipSelect is a flag guaranteeing that no SELECT is issued, for proxies without SELECT support (requires DB 0).
+	Extra                 map[string]string                                           // Extra holds backend-specific flags without a dedicated field yet; unknown keys are preserved.
+	PubSubOnly            bool                                                        // PubSubOnly is a flag indicating that the configuration was built by NewRedisPubSubConfig for pub/sub-only use.
+	Network               string                                                      // Network is the network type: "tcp" (default) or "unix", in which case Addr is a socket path.
+	DialTimeout           time.Duration                                               // DialTimeout is the timeout for establishing new connections (0 uses the go-redis default).
+	PoolTimeout           time.Duration                                               // PoolTimeout is how long a command waits for a free pooled connection before failing (0 falls back to TimeoutDefault seconds).
+	frozen                bool                                                        // frozen is a flag indicating that the configuration must not be mutated in place.
+	*TLSOptions                                                                       // TLSOptions is the TLS material the TLS configuration was loaded from with SetTLSOptions (nil otherwise).
+}
+
+// ParsedAddr returns the Redis server address split into its host and port.
+//
+// Returns:
+//   - Address: The parsed Redis server address
+//   - error: An error if Addr is not a valid "host:port"
+func (c *RedisConfig) ParsedAddr() (Address, error) {
+	return ParseAddress(c.Addr)
 }
@@ -0,0 +1,110 @@
+package alex
+
+import (
+	"strconv"
+	"strings"
+)
+
+// redisRedacted replaces secret values in the output of RedisConfigTextDiff.
+const redisRedacted = "<redacted>"
+
+// RedisConfigTextDiff returns a unified-diff-style description of the fields that differ between
+// two Redis configurations, suitable for printing in a terminal or a review comment.
+// Each changed field produces a "-name: old" line followed by a "+name: new" line, under a
+// "--- old" / "+++ new" header. Secrets are never printed: a changed password is listed with
+// "<redacted>" on both sides. A nil configuration contributes no lines for its side.
+//
+// Parameters:
+//   - old: The previous configuration
+//   - new: The updated configuration
+//
+// Returns:
+//   - string: The multi-line diff, or an empty string when both configurations are identical
+//
+// Example:
+//
+//	fmt.Print(RedisConfigTextDiff(current, next))
+//	// --- old
+//	// +++ new
+//	// -db: 0
+//	// +db: 1
+func RedisConfigTextDiff(old, new *RedisConfig) string {
+	oldFields, newFields := redisDiffFields(old), redisDiffFields(new)
+	var b strings.Builder
+	for i, name := range redisDiffFieldNames {
+		o, n := oldFields[i], newFields[i]
+		if o == n {
+			continue
+		}
+		if b.Len() == 0 {
+			b.WriteString("--- old\n+++ new\n")
+		}
+		if name == "password" {
+			o, n = redactDiffValue(o), redactDiffValue(n)
+		}
+		if old != nil {
+			b.WriteString("-" + name + ": " + o + "\n")
+		}
+		if new != nil {
+			b.WriteString("+" + name + ": " + n + "\n")
+		}
+	}
+	return b.String()
+}
+
+// redisDiffFieldNames lists the names of the fields compared by RedisConfigTextDiff,
+// in the order of the values returned by redisDiffFields.
+var redisDiffFieldNames = []string{
+	"enabled", "addr", "network", "username", "password", "db", "tls", "tls_server_name", "read_timeout", "max_pipeline_length",
+	"default_tx_timeout", "pool_size", "warmup_conns", "lazy_connect", "command_timeout", "retry_max_attempts", "retry_base_delay",
+	"retry_max_delay", "retry_jitter",
+}
+
+// redisDiffFields renders the fields of c compared by RedisConfigTextDiff as strings.
+// A nil configuration yields empty strings for every field.
+//
+// Parameters:
+//   - c: The configuration to render
+//
+// Returns:
+//   - []string: The rendered fields, in the order of redisDiffFieldNames
+func redisDiffFields(c *RedisConfig) []string {
+	if c == nil {
+		return make([]string, len(redisDiffFieldNames))
+	}
+	return []string{
+		strconv.FormatBool(c.Enabled),
+		c.Addr,
+		c.Network,
+		c.Username,
+		c.Password,
+		strconv.Itoa(c.DB),
+		strconv.FormatBool(c.TLSConfig != nil),
+		c.TLSServerName,
+		c.ReadTimeout.String(),
+		strconv.Itoa(c.MaxPipelineLength),
+		c.DefaultTxTimeout.String(),
+		strconv.Itoa(c.PoolSize),
+		strconv.Itoa(c.WarmupConns),
+		strconv.FormatBool(c.LazyConnect),
+		c.CommandTimeout.String(),
+		strconv.Itoa(c.MaxAttempts),
+		c.BaseDelay.String(),
+		c.MaxDelay.String(),
+		strconv.FormatBool(c.Jitter),
+	}
+}
+
+// redactDiffValue hides a secret value, keeping an unset secret visible as empty.
+//
+// Parameters:
+//   - v: The secret value
+//
+// Returns:
+//   - string: "<redacted>" if v is set, or an empty string otherwise
+func redactDiffValue(v string) string {
+	if v == "" {
+		return ""
+	}
+	return redisRedacted
+}
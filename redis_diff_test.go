@@ -0,0 +1,77 @@
+package alex
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedisConfigTextDiff(t *testing.T) {
+	base := func(opts ...func(*RedisConfigOptionsBuilder)) *RedisConfig {
+		builder := NewRedisConfigOptions().SetAddr("localhost:6379").SetPassword("old-secret")
+		for _, opt := range opts {
+			opt(builder)
+		}
+		config, err := NewRedisConfig(builder)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return config
+	}
+	tests := []struct {
+		name string
+		old  *RedisConfig
+		new  *RedisConfig
+		want string
+	}{
+		{name: "no_change", old: base(), new: base(), want: ""},
+		{
+			name: "single_change",
+			old:  base(),
+			new:  base(func(b *RedisConfigOptionsBuilder) { b.SetDB(1) }),
+			want: "--- old\n+++ new\n-db: 0\n+db: 1\n",
+		},
+		{
+			name: "secret_change",
+			old:  base(),
+			new:  base(func(b *RedisConfigOptionsBuilder) { b.SetPassword("new-secret") }),
+			want: "--- old\n+++ new\n-password: <redacted>\n+password: <redacted>\n",
+		},
+		{
+			name: "secret_removed",
+			old:  base(),
+			new:  base(func(b *RedisConfigOptionsBuilder) { b.SetPassword("") }),
+			want: "--- old\n+++ new\n-password: <redacted>\n+password: \n",
+		},
+		{
+			name: "secret_unchanged_with_other_change",
+			old:  base(),
+			new:  base(func(b *RedisConfigOptionsBuilder) { b.SetAddr("cache:6380") }),
+			want: "--- old\n+++ new\n-addr: localhost:6379\n+addr: cache:6380\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RedisConfigTextDiff(tt.old, tt.new)
+			if got != tt.want {
+				t.Errorf("RedisConfigTextDiff() =\n%s\nwant\n%s", got, tt.want)
+			}
+			if strings.Contains(got, "secret") {
+				t.Errorf("RedisConfigTextDiff() leaks a secret:\n%s", got)
+			}
+		})
+	}
+}
+
+func TestRedisConfigTextDiffNil(t *testing.T) {
+	config, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("localhost:6379"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := RedisConfigTextDiff(nil, config)
+	if !strings.HasPrefix(got, "--- old\n+++ new\n") || !strings.Contains(got, "+addr: localhost:6379\n") || strings.Contains(got, "\n-") {
+		t.Errorf("RedisConfigTextDiff(nil, config) =\n%s\nwant only added lines", got)
+	}
+	if got := RedisConfigTextDiff(nil, nil); got != "" {
+		t.Errorf("RedisConfigTextDiff(nil, nil) = %q, want empty", got)
+	}
+}
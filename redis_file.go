@@ -0,0 +1,139 @@
+package alex
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"go.yaml.in/yaml/v3"
+)
+
+// LoadRedisConfigFile reads a Redis configuration file and builds a validated RedisConfig from it.
+// The format is picked from the file extension: ".json", ".yaml"/".yml", or ".toml".
+// The document is a flat object using the keys of RedisConfig.ToMap (e.g., "addr", "db", "read_timeout").
+//
+// Parameters:
+//   - path: The path of the configuration file
+//
+// Returns:
+//   - *RedisConfig: A pointer to the final Redis configuration instance
+//   - error: An error if the extension is unsupported, the file cannot be read or decoded, or validation fails
+//
+// Example:
+//
+//	config, err := LoadRedisConfigFile("config/redis.yaml")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func LoadRedisConfigFile(path string) (*RedisConfig, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	format := strings.TrimPrefix(ext, ".")
+	if redisConfigDecoder(format) == nil {
+		return nil, fmt.Errorf("redis config file extension %q is not supported (use .json, .yaml, .yml, or .toml)", ext)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	config, err := NewRedisConfigFromReader(f, format)
+	if err != nil {
+		return nil, fmt.Errorf("load redis config file %s: %w", path, err)
+	}
+	return config, nil
+}
+
+// NewRedisConfigFromReader stream-decodes a Redis configuration document from r and builds
+// a validated RedisConfig from it, without buffering the whole input first.
+// The document is a flat object using the keys of RedisConfig.ToMap, as with LoadRedisConfigFile.
+// This is handy for configurations coming from HTTP bodies or an embedded file system.
+//
+// Parameters:
+//   - r: The reader the document is decoded from
+//   - format: The document format: "json", "yaml" (or "yml"), or "toml"
+//
+// Returns:
+//   - *RedisConfig: A pointer to the final Redis configuration instance
+//   - error: An error, prefixed with the format name, if the format is unsupported, decoding fails, a value is not a scalar, or validation fails
+//
+// Example:
+//
+//	config, err := NewRedisConfigFromReader(req.Body, "json")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewRedisConfigFromReader(r io.Reader, format string) (*RedisConfig, error) {
+	decode := redisConfigDecoder(format)
+	if decode == nil {
+		return nil, fmt.Errorf("redis config format %q is not supported (use json, yaml, yml, or toml)", format)
+	}
+	var doc map[string]any
+	if err := decode(r, &doc); err != nil {
+		return nil, fmt.Errorf("decode %s redis config: %w", format, err)
+	}
+	m, err := stringifyMap(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%s redis config: %w", format, err)
+	}
+	config, err := NewRedisConfigFromMap(m)
+	if err != nil {
+		return nil, fmt.Errorf("%s redis config: %w", format, err)
+	}
+	return config, nil
+}
+
+// redisConfigDecoder returns the streaming decoder for the given document format.
+//
+// Parameters:
+//   - format: The document format: "json", "yaml", "yml", or "toml" (case-insensitive)
+//
+// Returns:
+//   - func(io.Reader, *map[string]any) error: The decoder, or nil if the format is unsupported
+func redisConfigDecoder(format string) func(io.Reader, *map[string]any) error {
+	switch strings.ToLower(format) {
+	case "json":
+		return func(r io.Reader, m *map[string]any) error {
+			decoder := json.NewDecoder(r)
+			decoder.UseNumber()
+			return decoder.Decode(m)
+		}
+	case "yaml", "yml":
+		return func(r io.Reader, m *map[string]any) error { return yaml.NewDecoder(r).Decode(m) }
+	case "toml":
+		return func(r io.Reader, m *map[string]any) error {
+			_, err := toml.NewDecoder(r).Decode(m)
+			return err
+		}
+	}
+	return nil
+}
+
+// stringifyMap converts the scalar values of a decoded document into their string form,
+// so that documents of every format can be loaded through the string-map loaders.
+// Null values (e.g., a YAML "password:" with nothing after it) are skipped, as if the key were absent.
+//
+// Parameters:
+//   - doc: The decoded document
+//
+// Returns:
+//   - map[string]string: The document with every value in string form
+//   - error: An error naming the key if a value is a nested object or a list
+func stringifyMap(doc map[string]any) (map[string]string, error) {
+	m := make(map[string]string, len(doc))
+	for k, v := range doc {
+		if v == nil {
+			continue
+		}
+		switch reflect.ValueOf(v).Kind() {
+		case reflect.Map, reflect.Slice, reflect.Array:
+			return nil, fmt.Errorf("key %q must be a scalar value, not %T", k, v)
+		}
+		m[k] = fmt.Sprint(v)
+	}
+	return m, nil
+}
@@ -0,0 +1,136 @@
+package alex
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeTempFile writes content to a file with the given name in a temporary directory and returns its path.
+func writeTempFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadRedisConfigFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "redis.json", content: `{"addr": "cache:6380", "password": "secret", "db": 2, "read_timeout": "5s"}`},
+		{name: "redis.yaml", content: "addr: cache:6380\npassword: secret\ndb: 2\nread_timeout: 5s\n"},
+		{name: "redis.yml", content: "addr: cache:6380\npassword: secret\ndb: 2\nread_timeout: 5s\n"},
+		{name: "redis.toml", content: "addr = \"cache:6380\"\npassword = \"secret\"\ndb = 2\nread_timeout = \"5s\"\n"},
+		{name: "REDIS.JSON", content: `{"addr": "cache:6380", "password": "secret", "db": 2, "read_timeout": "5s"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := LoadRedisConfigFile(writeTempFile(t, tt.name, tt.content))
+			if err != nil {
+				t.Fatalf("LoadRedisConfigFile() error = %v", err)
+			}
+			if config.Addr != "cache:6380" || config.Password != "secret" || config.DB != 2 || config.ReadTimeout != 5*time.Second {
+				t.Errorf("config = %s/%q/%d/%v, want cache:6380/secret/2/5s", config.Addr, config.Password, config.DB, config.ReadTimeout)
+			}
+		})
+	}
+}
+
+func TestLoadRedisConfigFileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		wantErr string
+	}{
+		{name: "unsupported_extension", file: "redis.ini", content: "addr=cache:6380", wantErr: `extension ".ini" is not supported`},
+		{name: "no_extension", file: "redis", content: "{}", wantErr: `extension "" is not supported`},
+		{name: "malformed", file: "redis.json", content: `{"addr": `, wantErr: "decode json redis config"},
+		{name: "invalid", file: "redis.yaml", content: "db: 2\n", wantErr: "redis address is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadRedisConfigFile(writeTempFile(t, tt.file, tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("LoadRedisConfigFile() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+	if _, err := LoadRedisConfigFile(filepath.Join(t.TempDir(), "missing.json")); !os.IsNotExist(err) {
+		t.Errorf("LoadRedisConfigFile() error = %v, want a not-exist error", err)
+	}
+}
+
+func TestNewRedisConfigFromReader(t *testing.T) {
+	tests := []struct {
+		format  string
+		content string
+	}{
+		{format: "json", content: `{"addr": "cache:6380", "db": 1}`},
+		{format: "yaml", content: "addr: cache:6380\ndb: 1\n"},
+		{format: "YML", content: "addr: cache:6380\ndb: 1\n"},
+		{format: "toml", content: "addr = \"cache:6380\"\ndb = 1\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			config, err := NewRedisConfigFromReader(strings.NewReader(tt.content), tt.format)
+			if err != nil {
+				t.Fatalf("NewRedisConfigFromReader() error = %v", err)
+			}
+			if config.Addr != "cache:6380" || config.DB != 1 {
+				t.Errorf("config = %s/%d, want cache:6380/1", config.Addr, config.DB)
+			}
+		})
+	}
+}
+
+func TestNewRedisConfigFromReaderErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		content string
+		wantErr string
+	}{
+		{name: "truncated_json", format: "json", content: `{"addr": "cache:6380", "db`, wantErr: "decode json redis config"},
+		{name: "truncated_yaml", format: "yaml", content: "addr: [cache:6380\n", wantErr: "decode yaml redis config"},
+		{name: "invalid", format: "json", content: `{"addr": "cache:6380", "db": -1}`, wantErr: "json redis config: redis database"},
+		{name: "unsupported_format", format: "xml", content: "<redis/>", wantErr: `format "xml" is not supported`},
+		{name: "nested_map", format: "yaml", content: "addr: cache:6380\ntls:\n  enabled: true\n", wantErr: `yaml redis config: key "tls" must be a scalar value`},
+		{name: "list", format: "json", content: `{"addr": ["cache:6380"]}`, wantErr: `json redis config: key "addr" must be a scalar value`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRedisConfigFromReader(strings.NewReader(tt.content), tt.format)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("NewRedisConfigFromReader() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewRedisConfigFromReaderNullValues(t *testing.T) {
+	tests := []struct {
+		format  string
+		content string
+	}{
+		{format: "yaml", content: "addr: cache:6380\nusername:\npassword:\n"},
+		{format: "json", content: `{"addr": "cache:6380", "username": null, "password": null}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			config, err := NewRedisConfigFromReader(strings.NewReader(tt.content), tt.format)
+			if err != nil {
+				t.Fatalf("NewRedisConfigFromReader() error = %v", err)
+			}
+			if config.Username != "" || config.Password != "" {
+				t.Errorf("Username, Password = %q, %q, want both empty for null values", config.Username, config.Password)
+			}
+		})
+	}
+}
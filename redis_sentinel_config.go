@@ -0,0 +1,202 @@
+package alex
+
+import (
+	"slices"
+)
+
+// RedisSentinelOptions holds the configuration options for connecting to a Redis deployment managed by Sentinel.
+// It includes the name of the monitored master, the addresses of the Sentinel nodes,
+// optional credentials for the master, and the database number to select.
+// This struct is used as input for building the final RedisSentinelConfig.
+type RedisSentinelOptions struct {
+	MasterName    string            // MasterName is the name of the master monitored by the Sentinel nodes (e.g., "mymaster").
+	SentinelAddrs []string          // SentinelAddrs are the addresses of the Sentinel nodes (e.g., "localhost:26379").
+	Username      string            // Username is the optional ACL username for the Redis master.
+	Password      string            // Password is the optional authentication password for the Redis master.
+	DB            int               // DB is the database number to be selected within the Redis instance (default is 0).
+	Extra         map[string]string // Extra holds backend-specific flags without a dedicated field yet; unknown keys are preserved.
+	TrimSpace     bool              // TrimSpace is a flag indicating whether the constructor trims leading and trailing white space from the string fields.
+}
+
+// RedisSentinelOptionsBuilder provides a builder pattern for constructing RedisSentinelOptions.
+// It accumulates option functions that can be applied to configure a RedisSentinelOptions instance.
+// This builder implements the builderutil.Lister interface to work with the functional options pattern.
+type RedisSentinelOptionsBuilder struct {
+	Opts []func(*RedisSentinelOptions) error // Opts contains the list of option functions to be applied
+}
+
+// SetMasterName configures the name of the master monitored by the Sentinel nodes.
+// It appends an option function that sets the MasterName field of RedisSentinelOptions.
+//
+// Parameters:
+//   - masterName: The name of the monitored master (e.g., "mymaster")
+//
+// Returns:
+//   - *RedisSentinelOptionsBuilder: The builder instance for method chaining
+func (b *RedisSentinelOptionsBuilder) SetMasterName(masterName string) *RedisSentinelOptionsBuilder {
+	b.Opts = append(b.Opts, func(o *RedisSentinelOptions) error {
+		o.MasterName = masterName
+		return nil
+	})
+	return b
+}
+
+// SetSentinelAddrs configures the addresses of the Sentinel nodes.
+// It appends an option function that sets the SentinelAddrs field of RedisSentinelOptions.
+//
+// Parameters:
+//   - addrs: The Sentinel node addresses in "host:port" form
+//
+// Returns:
+//   - *RedisSentinelOptionsBuilder: The builder instance for method chaining
+func (b *RedisSentinelOptionsBuilder) SetSentinelAddrs(addrs ...string) *RedisSentinelOptionsBuilder {
+	b.Opts = append(b.Opts, func(o *RedisSentinelOptions) error {
+		o.SentinelAddrs = addrs
+		return nil
+	})
+	return b
+}
+
+// SetUsername configures the ACL username for the Redis master.
+// It appends an option function that sets the Username field of RedisSentinelOptions.
+//
+// Parameters:
+//   - username: The ACL username for the Redis master
+//
+// Returns:
+//   - *RedisSentinelOptionsBuilder: The builder instance for method chaining
+func (b *RedisSentinelOptionsBuilder) SetUsername(username string) *RedisSentinelOptionsBuilder {
+	b.Opts = append(b.Opts, func(o *RedisSentinelOptions) error {
+		o.Username = username
+		return nil
+	})
+	return b
+}
+
+// SetPassword configures the authentication password for the Redis master.
+// It appends an option function that sets the Password field of RedisSentinelOptions.
+// A "vault://path#key" reference is resolved at build time through the SecretResolver set with SetSecretResolver.
+//
+// Parameters:
+//   - password: The authentication password for the Redis master
+//
+// Returns:
+//   - *RedisSentinelOptionsBuilder: The builder instance for method chaining
+func (b *RedisSentinelOptionsBuilder) SetPassword(password string) *RedisSentinelOptionsBuilder {
+	b.Opts = append(b.Opts, func(o *RedisSentinelOptions) error {
+		o.Password = password
+		return nil
+	})
+	return b
+}
+
+// SetDB configures the Redis database number to select.
+// It appends an option function that sets the DB field of RedisSentinelOptions.
+//
+// Parameters:
+//   - db: The database number to select
+//
+// Returns:
+//   - *RedisSentinelOptionsBuilder: The builder instance for method chaining
+func (b *RedisSentinelOptionsBuilder) SetDB(db int) *RedisSentinelOptionsBuilder {
+	b.Opts = append(b.Opts, func(o *RedisSentinelOptions) error {
+		o.DB = db
+		return nil
+	})
+	return b
+}
+
+// SetExtra sets a backend-specific flag that has no dedicated field yet, as a forward-compatible escape hatch.
+// It appends an option function that adds the key/value pair to the Extra field of RedisSentinelOptions.
+// The constructor does not interpret the flags but preserves them in the final configuration.
+//
+// Parameters:
+//   - key: The flag name (must not be empty); setting an existing key replaces its value
+//   - value: The flag value
+//
+// Returns:
+//   - *RedisSentinelOptionsBuilder: The builder instance for method chaining
+func (b *RedisSentinelOptionsBuilder) SetExtra(key, value string) *RedisSentinelOptionsBuilder {
+	b.Opts = append(b.Opts, func(o *RedisSentinelOptions) error {
+		if o.Extra == nil {
+			o.Extra = make(map[string]string)
+		}
+		o.Extra[key] = value
+		return nil
+	})
+	return b
+}
+
+// List returns the slice of option functions accumulated by the builder.
+// This method implements the builderutil.Lister interface, allowing the builder
+// to be used with the builderutil.Build function.
+// The functions are returned in the order the setters were called and builderutil.Build applies them
+// in that order, so when several setters touch the same field the last call wins. The returned slice
+// is a copy, so callers cannot reorder or drop the queued options.
+//
+// Returns:
+//   - []func(*RedisSentinelOptions) error: A slice of option functions that can be applied to configure RedisSentinelOptions
+func (b *RedisSentinelOptionsBuilder) List() []func(*RedisSentinelOptions) error {
+	return slices.Clone(b.Opts)
+}
+
+// NewRedisSentinelOptions creates and returns a new instance of RedisSentinelOptionsBuilder.
+// This function provides a convenient way to initialize the builder for creating Redis Sentinel configuration options.
+//
+// Returns:
+//   - *RedisSentinelOptionsBuilder: A new instance of RedisSentinelOptionsBuilder ready to be configured
+//
+// Example:
+//
+//	builder := NewRedisSentinelOptions()
+//	config, err := NewRedisSentinelConfig(builder.SetMasterName("mymaster").SetSentinelAddrs("host1:26379", "host2:26379"))
+func NewRedisSentinelOptions() *RedisSentinelOptionsBuilder {
+	return &RedisSentinelOptionsBuilder{}
+}
+
+// SetTrimSpace configures whether leading and trailing white space is trimmed from the string fields before validation.
+// It appends an option function that sets the TrimSpace field of RedisSentinelOptions.
+// Trimming is off by default so that values are used exactly as given; enabling it guards against
+// a Sentinel address or password copied with a trailing space silently breaking the configuration.
+//
+// Parameters:
+//   - trim: A flag indicating whether the string fields are trimmed
+//
+// Returns:
+//   - *RedisSentinelOptionsBuilder: The builder instance for method chaining
+func (b *RedisSentinelOptionsBuilder) SetTrimSpace(trim bool) *RedisSentinelOptionsBuilder {
+	b.Opts = append(b.Opts, func(o *RedisSentinelOptions) error {
+		o.TrimSpace = trim
+		return nil
+	})
+	return b
+}
+
+// RedisSentinelConfig represents the final Redis Sentinel configuration used for establishing connections.
+// This struct is created from RedisSentinelOptions after validation and contains all the necessary
+// parameters for discovering and connecting to the Redis master through Sentinel.
+type RedisSentinelConfig struct {
+	MasterName    string            // MasterName is the name of the master monitored by the Sentinel nodes (e.g., "mymaster").
+	SentinelAddrs []string          // SentinelAddrs are the addresses of the Sentinel nodes (e.g., "localhost:26379").
+	Username      string            // Username is the optional ACL username for the Redis master.
+	Password      string            // Password is the optional authentication password for the Redis master.
+	DB            int               // DB is the database number to be selected within the Redis instance (default is 0).
+	Extra         map[string]string // Extra holds backend-specific flags without a dedicated field yet; unknown keys are preserved.
+}
+
+// ParsedSentinelAddrs returns the Sentinel node addresses split into their hosts and ports.
+//
+// Returns:
+//   - []Address: The parsed Sentinel node addresses, in the configured order
+//   - error: An error if any entry of SentinelAddrs is not a valid "host:port"
+func (c *RedisSentinelConfig) ParsedSentinelAddrs() ([]Address, error) {
+	addrs := make([]Address, 0, len(c.SentinelAddrs))
+	for _, s := range c.SentinelAddrs {
+		addr, err := ParseAddress(s)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
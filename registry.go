@@ -0,0 +1,72 @@
+package alex
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Registry holds Redis configurations keyed by a logical name (e.g., "sessions", "cache", "jobs"),
+// giving large applications a central place to resolve configs by name.
+// A Registry is safe for concurrent use; its zero value is ready to use.
+type Registry struct {
+	mu      sync.RWMutex            // mu guards configs
+	configs map[string]*RedisConfig // configs maps each registered name to its configuration
+}
+
+// NewRegistry creates and returns a new, empty Registry.
+//
+// Returns:
+//   - *Registry: A new Registry ready to accept registrations
+//
+// Example:
+//
+//	registry := NewRegistry()
+//	if err := registry.Register("cache", config); err != nil {
+//	    log.Fatal(err)
+//	}
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds a configuration under the given name.
+//
+// Parameters:
+//   - name: The logical name of the configuration
+//   - cfg: The configuration to register
+//
+// Returns:
+//   - error: An error if the name is empty, the configuration is nil, or the name is already registered
+func (r *Registry) Register(name string, cfg *RedisConfig) error {
+	if name == "" {
+		return errors.New("registry name is required")
+	}
+	if cfg == nil {
+		return fmt.Errorf("registry config %q is nil", name)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.configs[name]; ok {
+		return fmt.Errorf("registry config %q is already registered", name)
+	}
+	if r.configs == nil {
+		r.configs = make(map[string]*RedisConfig)
+	}
+	r.configs[name] = cfg
+	return nil
+}
+
+// Get returns the configuration registered under the given name.
+//
+// Parameters:
+//   - name: The logical name of the configuration
+//
+// Returns:
+//   - *RedisConfig: The registered configuration, or nil if none is registered under name
+//   - bool: true if a configuration is registered under name
+func (r *Registry) Get(name string) (*RedisConfig, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	cfg, ok := r.configs[name]
+	return cfg, ok
+}
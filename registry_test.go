@@ -0,0 +1,59 @@
+package alex
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestRegistryRegister(t *testing.T) {
+	registry := NewRegistry()
+	sessions := &RedisConfig{Addr: "localhost:6379"}
+	if err := registry.Register("sessions", sessions); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if err := registry.Register("sessions", &RedisConfig{Addr: "localhost:6380"}); err == nil {
+		t.Error("Register() of a duplicate name error = nil, want an error")
+	}
+	if got, ok := registry.Get("sessions"); !ok || got != sessions {
+		t.Errorf("Get(sessions) = %v, %t, want the first registered config", got, ok)
+	}
+	if got, ok := registry.Get("cache"); ok || got != nil {
+		t.Errorf("Get(cache) = %v, %t, want nil, false", got, ok)
+	}
+	if err := registry.Register("", sessions); err == nil {
+		t.Error("Register() with an empty name error = nil, want an error")
+	}
+	if err := registry.Register("jobs", nil); err == nil {
+		t.Error("Register() with a nil config error = nil, want an error")
+	}
+	var zero Registry
+	if err := zero.Register("jobs", sessions); err != nil {
+		t.Errorf("Register() on a zero Registry error = %v", err)
+	}
+}
+
+func TestRegistryConcurrentAccess(t *testing.T) {
+	registry := NewRegistry()
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		name := fmt.Sprintf("redis-%d", i)
+		go func() {
+			defer wg.Done()
+			if err := registry.Register(name, &RedisConfig{Addr: "localhost:6379"}); err != nil {
+				t.Errorf("Register(%s) error = %v", name, err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			registry.Get(name)
+		}()
+	}
+	wg.Wait()
+	for i := 0; i < 50; i++ {
+		if _, ok := registry.Get(fmt.Sprintf("redis-%d", i)); !ok {
+			t.Errorf("redis-%d is not registered", i)
+		}
+	}
+}
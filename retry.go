@@ -0,0 +1,75 @@
+package alex
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy describes how an operation is retried, using exponential backoff with optional jitter.
+// It is embedded by the backend configurations so Redis, Minio, and HTTP clients share the same retry settings.
+type RetryPolicy struct {
+	MaxAttempts int           // MaxAttempts is the maximum number of attempts, including the first one (0 uses the client default).
+	BaseDelay   time.Duration // BaseDelay is the delay before the first retry, doubled for every further retry.
+	MaxDelay    time.Duration // MaxDelay caps the delay between retries (0 means no cap).
+	Jitter      bool          // Jitter is a flag indicating whether delays are randomized to avoid synchronized retries.
+}
+
+// Next returns the delay to wait before the given retry attempt.
+// The delay grows as BaseDelay * 2^(attempt-1) and is capped at MaxDelay when it is set.
+// With Jitter enabled, the delay is randomized within [delay/2, delay].
+//
+// Parameters:
+//   - attempt: The retry attempt number, starting at 1
+//
+// Returns:
+//   - time.Duration: The delay to wait before the attempt (0 if attempt is less than 1)
+//
+// Example:
+//
+//	policy := RetryPolicy{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+//	for attempt := 1; attempt < policy.MaxAttempts; attempt++ {
+//	    time.Sleep(policy.Next(attempt))
+//	}
+func (p RetryPolicy) Next(attempt int) time.Duration {
+	if attempt < 1 || p.BaseDelay <= 0 {
+		return 0
+	}
+	delay := p.BaseDelay
+	for i := 1; i < attempt; i++ {
+		if delay > time.Duration(1<<62)/2 {
+			break
+		}
+		delay *= 2
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			break
+		}
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if p.Jitter {
+		half := delay / 2
+		delay = half + rand.N(delay-half+1)
+	}
+	return delay
+}
+
+// Validate checks that the retry policy values are consistent.
+//
+// Returns:
+//   - error: An error if a value is negative or MaxDelay is lower than BaseDelay
+func (p RetryPolicy) Validate() error {
+	if p.MaxAttempts < 0 {
+		return newValidationError("MaxAttempts", RuleMin, "retry policy max attempts must be greater than or equal to 0")
+	}
+	if p.BaseDelay < 0 {
+		return newValidationError("BaseDelay", RuleMin, "retry policy base delay must be greater than or equal to 0")
+	}
+	if p.MaxDelay < 0 {
+		return newValidationError("MaxDelay", RuleMin, "retry policy max delay must be greater than or equal to 0")
+	}
+	if p.MaxDelay > 0 && p.MaxDelay < p.BaseDelay {
+		return newValidationError("MaxDelay", RuleMin, "retry policy max delay must be greater than or equal to the base delay")
+	}
+	return nil
+}
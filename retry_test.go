@@ -0,0 +1,79 @@
+package alex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyNext(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 10, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 0, want: 0},
+		{attempt: 1, want: 100 * time.Millisecond},
+		{attempt: 2, want: 200 * time.Millisecond},
+		{attempt: 3, want: 400 * time.Millisecond},
+		{attempt: 4, want: 800 * time.Millisecond},
+		{attempt: 5, want: time.Second},
+		{attempt: 100, want: time.Second},
+	}
+	for _, tt := range tests {
+		if got := policy.Next(tt.attempt); got != tt.want {
+			t.Errorf("Next(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+func TestRetryPolicyNextUncapped(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Millisecond}
+	if got, want := policy.Next(11), 1024*time.Millisecond; got != want {
+		t.Errorf("Next(11) = %v, want %v", got, want)
+	}
+	if got := policy.Next(1000); got <= 0 {
+		t.Errorf("Next(1000) = %v, want a positive delay without overflow", got)
+	}
+}
+
+func TestRetryPolicyNextJitter(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, Jitter: true}
+	for attempt := 1; attempt <= 6; attempt++ {
+		ceiling := RetryPolicy{BaseDelay: policy.BaseDelay, MaxDelay: policy.MaxDelay}.Next(attempt)
+		for i := 0; i < 50; i++ {
+			got := policy.Next(attempt)
+			if got < ceiling/2 || got > ceiling {
+				t.Fatalf("Next(%d) = %v, want within [%v, %v]", attempt, got, ceiling/2, ceiling)
+			}
+		}
+	}
+}
+
+func TestRetryPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy RetryPolicy
+		field  string
+	}{
+		{name: "negative_attempts", policy: RetryPolicy{MaxAttempts: -1}, field: "MaxAttempts"},
+		{name: "negative_base_delay", policy: RetryPolicy{BaseDelay: -time.Second}, field: "BaseDelay"},
+		{name: "negative_max_delay", policy: RetryPolicy{MaxDelay: -time.Second}, field: "MaxDelay"},
+		{name: "max_below_base", policy: RetryPolicy{BaseDelay: time.Second, MaxDelay: time.Millisecond}, field: "MaxDelay"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireValidationError(t, tt.policy.Validate(), tt.field, RuleMin)
+		})
+	}
+	if err := (RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Second}).Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+}
+
+func TestConfigsValidateEmbeddedRetryPolicy(t *testing.T) {
+	invalid := RetryPolicy{BaseDelay: time.Second, MaxDelay: time.Millisecond}
+	_, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("localhost:6379").SetRetryPolicy(invalid))
+	requireValidationError(t, err, "RetryPolicy.MaxDelay", RuleMin)
+	_, err = NewMinioConfig(newTestMinioOptions().SetRetryPolicy(invalid))
+	requireValidationError(t, err, "RetryPolicy.MaxDelay", RuleMin)
+}
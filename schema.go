@@ -0,0 +1,35 @@
+package alex
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// SchemaVersion is the version of the configuration schema produced by the serializers of the package
+// (e.g., RedisConfig.ToMap). It is increased whenever the serialized layout changes incompatibly.
+const SchemaVersion = 1
+
+// SchemaVersionKey is the key holding the schema version in serialized configurations.
+const SchemaVersionKey = "schema_version"
+
+// checkSchemaVersion reports an error when a serialized configuration was written by a newer,
+// incompatible schema. A missing version is treated as the current one.
+//
+// Parameters:
+//   - version: The serialized schema version, or an empty string when absent
+//
+// Returns:
+//   - error: An error if the version is not a number or is newer than SchemaVersion
+func checkSchemaVersion(version string) error {
+	if version == "" {
+		return nil
+	}
+	v, err := strconv.Atoi(version)
+	if err != nil {
+		return fmt.Errorf("config schema version %q is invalid: %w", version, err)
+	}
+	if v > SchemaVersion {
+		return fmt.Errorf("config schema version %d is newer than the supported version %d", v, SchemaVersion)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package alex
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestCheckSchemaVersion(t *testing.T) {
+	for _, version := range []string{"", "1", strconv.Itoa(SchemaVersion)} {
+		if err := checkSchemaVersion(version); err != nil {
+			t.Errorf("checkSchemaVersion(%q) error = %v", version, err)
+		}
+	}
+	for _, version := range []string{strconv.Itoa(SchemaVersion + 1), "v1", "1.0"} {
+		if err := checkSchemaVersion(version); err == nil {
+			t.Errorf("checkSchemaVersion(%q) error = nil, want an error", version)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package alex
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// SecretRefPrefix marks a secret value as a reference to be resolved at build time
+// (e.g., "vault://secret/data/redis#password") rather than a literal secret.
+const SecretRefPrefix = "vault://"
+
+// SecretResolver fetches secrets referenced by "vault://path#key" values.
+// Implementations must be safe for concurrent use.
+type SecretResolver interface {
+	// Resolve returns the secret referenced by ref, which includes the "vault://" prefix.
+	Resolve(ctx context.Context, ref string) (string, error)
+}
+
+var (
+	secretResolverMu sync.RWMutex   // secretResolverMu guards secretResolver
+	secretResolver   SecretResolver // secretResolver is the package-level SecretResolver set by SetSecretResolver
+)
+
+// SetSecretResolver sets the package-level SecretResolver used to resolve "vault://" references
+// passed to secret setters such as SetPassword, SetAccessKey, and SetSecretKey.
+// Passing nil removes the resolver, after which building with a reference fails.
+//
+// Parameters:
+//   - r: The SecretResolver that fetches referenced secrets, or nil to remove it
+//
+// Example:
+//
+//	SetSecretResolver(vaultResolver)
+//	config, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("localhost:6379").SetPassword("vault://secret/data/redis#password"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func SetSecretResolver(r SecretResolver) {
+	secretResolverMu.Lock()
+	defer secretResolverMu.Unlock()
+	secretResolver = r
+}
+
+// resolveSecret returns value unchanged unless it is a "vault://" reference,
+// in which case it is resolved through the package-level SecretResolver.
+//
+// Parameters:
+//   - ctx: The context passed to the resolver
+//   - value: The literal secret or secret reference
+//
+// Returns:
+//   - string: The literal or resolved secret
+//   - error: An error if value is a malformed reference, no resolver is set, or resolution fails
+func resolveSecret(ctx context.Context, value string) (string, error) {
+	if !strings.HasPrefix(value, SecretRefPrefix) {
+		return value, nil
+	}
+	path, key, ok := strings.Cut(strings.TrimPrefix(value, SecretRefPrefix), "#")
+	if !ok || path == "" || key == "" {
+		return "", fmt.Errorf("secret reference %q must have the form vault://path#key", value)
+	}
+	secretResolverMu.RLock()
+	r := secretResolver
+	secretResolverMu.RUnlock()
+	if r == nil {
+		return "", fmt.Errorf("secret reference %q cannot be resolved: no SecretResolver is set", value)
+	}
+	secret, err := r.Resolve(ctx, value)
+	if err != nil {
+		return "", fmt.Errorf("resolve secret reference %q: %w", value, err)
+	}
+	return secret, nil
+}
+
+// readSecretFile reads a secret from the file at path, ignoring a trailing newline.
+// The file is not read once ctx is done.
+//
+// Parameters:
+//   - ctx: The context bounding the read
+//   - path: The path of the file holding the secret
+//
+// Returns:
+//   - string: The secret
+//   - error: The context error if ctx is done, or an error if the file cannot be read
+func readSecretFile(ctx context.Context, path string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
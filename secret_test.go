@@ -0,0 +1,156 @@
+package alex
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// stubResolver is a SecretResolver serving secrets from a map and recording the references it resolved.
+type stubResolver struct {
+	mu      sync.Mutex
+	secrets map[string]string
+	refs    []string
+}
+
+func (r *stubResolver) Resolve(ctx context.Context, ref string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.refs = append(r.refs, ref)
+	secret, ok := r.secrets[ref]
+	if !ok {
+		return "", errors.New("secret not found")
+	}
+	return secret, nil
+}
+
+// setTestSecretResolver installs r as the package SecretResolver for the duration of the test.
+func setTestSecretResolver(t *testing.T, r SecretResolver) {
+	t.Helper()
+	SetSecretResolver(r)
+	t.Cleanup(func() { SetSecretResolver(nil) })
+}
+
+func TestSecretResolverResolvesReferences(t *testing.T) {
+	resolver := &stubResolver{secrets: map[string]string{
+		"vault://secret/data/redis#password":    "redis-password",
+		"vault://secret/data/minio#access_key":  "minio-access",
+		"vault://secret/data/minio#secret_key":  "minio-secret-key",
+		"vault://secret/data/sentinel#password": "sentinel-password",
+	}}
+	setTestSecretResolver(t, resolver)
+
+	redis, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("localhost:6379").SetPassword("vault://secret/data/redis#password"))
+	if err != nil {
+		t.Fatalf("NewRedisConfig() error = %v", err)
+	}
+	if redis.Password != "redis-password" {
+		t.Errorf("Redis Password = %q, want the resolved secret", redis.Password)
+	}
+	minio, err := NewMinioConfig(newTestMinioOptions().
+		SetAccessKey("vault://secret/data/minio#access_key").
+		SetSecretKey("vault://secret/data/minio#secret_key"))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v", err)
+	}
+	if minio.AccessKey != "minio-access" || minio.SecretKey != "minio-secret-key" {
+		t.Errorf("Minio AccessKey, SecretKey = %q, %q, want the resolved secrets", minio.AccessKey, minio.SecretKey)
+	}
+	sentinel, err := NewRedisSentinelConfig(NewRedisSentinelOptions().
+		SetMasterName("mymaster").
+		SetSentinelAddrs("host1:26379").
+		SetPassword("vault://secret/data/sentinel#password"))
+	if err != nil {
+		t.Fatalf("NewRedisSentinelConfig() error = %v", err)
+	}
+	if sentinel.Password != "sentinel-password" {
+		t.Errorf("Sentinel Password = %q, want the resolved secret", sentinel.Password)
+	}
+	if len(resolver.refs) != 4 {
+		t.Errorf("resolved %v, want 4 references", resolver.refs)
+	}
+}
+
+func TestSecretResolverLiteralsUntouched(t *testing.T) {
+	resolver := &stubResolver{}
+	setTestSecretResolver(t, resolver)
+	config, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("localhost:6379").SetPassword("plain-password"))
+	if err != nil {
+		t.Fatalf("NewRedisConfig() error = %v", err)
+	}
+	if config.Password != "plain-password" || len(resolver.refs) != 0 {
+		t.Errorf("Password = %q with %d resolutions, want the literal and no resolution", config.Password, len(resolver.refs))
+	}
+}
+
+func TestSecretResolverErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		resolver SecretResolver
+		ref      string
+		wantErr  string
+	}{
+		{name: "missing_resolver", resolver: nil, ref: "vault://secret/data/redis#password", wantErr: "no SecretResolver is set"},
+		{name: "malformed_reference", resolver: &stubResolver{}, ref: "vault://secret/data/redis", wantErr: "must have the form vault://path#key"},
+		{name: "resolution_failure", resolver: &stubResolver{}, ref: "vault://secret/data/redis#password", wantErr: "secret not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setTestSecretResolver(t, tt.resolver)
+			_, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("localhost:6379").SetPassword(tt.ref))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("NewRedisConfig() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSecretFileConflicts(t *testing.T) {
+	path := writeTempFile(t, "secret", "file-secret\n")
+	tests := []struct {
+		name  string
+		build func() error
+		field string
+	}{
+		{name: "redis_password", build: func() error {
+			_, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("localhost:6379").SetPassword("literal").SetPasswordFile(path))
+			return err
+		}, field: "PasswordFile"},
+		{name: "minio_access_key", build: func() error {
+			_, err := NewMinioConfig(newTestMinioOptions().SetAccessKeyFile(path))
+			return err
+		}, field: "AccessKeyFile"},
+		{name: "minio_secret_key", build: func() error {
+			_, err := NewMinioConfig(newTestMinioOptions().SetSecretKeyFile(path))
+			return err
+		}, field: "SecretKeyFile"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireValidationError(t, tt.build(), tt.field, RuleConflict)
+		})
+	}
+}
+
+func TestSecretFiles(t *testing.T) {
+	password := writeTempFile(t, "password", "redis-password\n")
+	redis, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("localhost:6379").SetPasswordFile(password))
+	if err != nil {
+		t.Fatalf("NewRedisConfig() error = %v", err)
+	}
+	if redis.Password != "redis-password" {
+		t.Errorf("Password = %q, want the file contents without the trailing newline", redis.Password)
+	}
+
+	accessKey := writeTempFile(t, "access_key", "file-access")
+	secretKey := writeTempFile(t, "secret_key", "file-secret-key\r\n")
+	minio, err := NewMinioConfig(newTestMinioOptions().SetAccessKey("").SetAccessKeyFile(accessKey).SetSecretKey("").SetSecretKeyFile(secretKey))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v", err)
+	}
+	if minio.AccessKey != "file-access" || minio.SecretKey != "file-secret-key" {
+		t.Errorf("AccessKey, SecretKey = %q, %q, want the file contents", minio.AccessKey, minio.SecretKey)
+	}
+}
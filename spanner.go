@@ -0,0 +1,109 @@
+package alex
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/zeroxsolutions/strike/builderutil"
+)
+
+// NewSpannerConfig creates a new SpannerConfig from SpannerOptions by applying the provided option functions.
+// It uses the builderutil package to build the configuration options in a functional options pattern,
+// then creates and returns a final SpannerConfig instance.
+//
+// Validation rules:
+//   - Project, instance, and database are required and cannot be empty
+//
+// Parameters:
+//   - opts: Variable number of option functions that configure the SpannerOptions
+//
+// Returns:
+//   - *SpannerConfig: A pointer to the final Spanner configuration instance
+//   - error: An error if the configuration building process fails or validation fails
+//
+// Example:
+//
+//	builder := NewSpannerOptions()
+//	config, err := NewSpannerConfig(builder.SetProject("my-project").SetInstance("main").SetDatabase("orders"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(config.DatabasePath())
+func NewSpannerConfig(opts ...builderutil.Lister[SpannerOptions]) (*SpannerConfig, error) {
+	options, err := builderutil.Build(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if options.TrimSpace {
+		trimSpace(&options.Project, &options.Instance, &options.Database, &options.CredentialsFile)
+	}
+	config := &SpannerConfig{
+		Project:         options.Project,
+		Instance:        options.Instance,
+		Database:        options.Database,
+		CredentialsFile: options.CredentialsFile,
+		Extra:           options.Extra,
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	notifyBuild(config)
+	return config, nil
+}
+
+// Validate checks that the SpannerConfig satisfies the rules enforced by its constructor.
+// It performs no I/O, so it can be used to re-check a configuration at any time.
+//
+// Returns:
+//   - error: A *ValidationError describing the first rule the configuration violates, or nil if it is valid
+func (c *SpannerConfig) Validate() error {
+	if c.Project == "" {
+		return newValidationError("Project", RuleRequired, "spanner project is required")
+	}
+	if c.Instance == "" {
+		return newValidationError("Instance", RuleRequired, "spanner instance is required")
+	}
+	if c.Database == "" {
+		return newValidationError("Database", RuleRequired, "spanner database is required")
+	}
+	if err := validateExtra(c.Extra, "spanner"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DatabasePath returns the fully qualified resource name of the database, as expected by the Spanner client.
+//
+// Returns:
+//   - string: The path "projects/<project>/instances/<instance>/databases/<database>"
+func (c *SpannerConfig) DatabasePath() string {
+	return fmt.Sprintf("projects/%s/instances/%s/databases/%s", c.Project, c.Instance, c.Database)
+}
+
+// Labels returns identifying, non-secret key/value pairs describing the configuration.
+//
+// Returns:
+//   - map[string]string: The "kind", "project", "instance", and "database" labels
+func (c *SpannerConfig) Labels() map[string]string {
+	return map[string]string{
+		"kind":     "spanner",
+		"project":  c.Project,
+		"instance": c.Instance,
+		"database": c.Database,
+	}
+}
+
+// LogValue implements slog.LogValuer, so that logging the configuration with log/slog
+// (e.g., slog.Info("config", "spanner", cfg)) emits a group of its main fields.
+// Only the path of the credentials file is logged, never its content. Extra flags are not logged.
+//
+// Returns:
+//   - slog.Value: A group value holding the logged fields
+func (c *SpannerConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("project", c.Project),
+		slog.String("instance", c.Instance),
+		slog.String("database", c.Database),
+		slog.String("credentials_file", c.CredentialsFile),
+	)
+}
@@ -0,0 +1,167 @@
+package alex
+
+import "slices"
+
+// SpannerOptions represents the configuration options for a Cloud Spanner client.
+// It includes the project, instance, and database identifiers, and an optional credentials file.
+type SpannerOptions struct {
+	Project         string            // Project is the Google Cloud project ID (e.g., "my-project").
+	Instance        string            // Instance is the Spanner instance ID.
+	Database        string            // Database is the Spanner database ID.
+	CredentialsFile string            // CredentialsFile is the optional path of a service account credentials file.
+	Extra           map[string]string // Extra holds backend-specific flags without a dedicated field yet; unknown keys are preserved.
+	TrimSpace       bool              // TrimSpace is a flag indicating whether the constructor trims leading and trailing white space from the string fields.
+}
+
+// SpannerOptionsBuilder provides a builder pattern for constructing SpannerOptions.
+// It accumulates option functions that can be applied to configure a SpannerOptions instance.
+// This builder implements the builderutil.Lister interface to work with the functional options pattern.
+type SpannerOptionsBuilder struct {
+	Opts []func(*SpannerOptions) error // Opts contains the list of option functions to be applied
+}
+
+// SetExtra sets a backend-specific flag that has no dedicated field yet, as a forward-compatible escape hatch.
+// It appends an option function that adds the key/value pair to the Extra field of SpannerOptions.
+// The constructor does not interpret the flags but preserves them in the final configuration.
+//
+// Parameters:
+//   - key: The flag name (must not be empty); setting an existing key replaces its value
+//   - value: The flag value
+//
+// Returns:
+//   - *SpannerOptionsBuilder: The builder instance for method chaining
+func (builder *SpannerOptionsBuilder) SetExtra(key, value string) *SpannerOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *SpannerOptions) error {
+		if args.Extra == nil {
+			args.Extra = make(map[string]string)
+		}
+		args.Extra[key] = value
+		return nil
+	})
+	return builder
+}
+
+// List returns the slice of option functions accumulated by the builder.
+// This method implements the builderutil.Lister interface, allowing the builder
+// to be used with the builderutil.Build function.
+// The functions are returned in the order the setters were called and builderutil.Build applies them
+// in that order, so when several setters touch the same field the last call wins. The returned slice
+// is a copy, so callers cannot reorder or drop the queued options.
+//
+// Returns:
+//   - []func(*SpannerOptions) error: A slice of option functions that can be applied to configure SpannerOptions
+func (builder *SpannerOptionsBuilder) List() []func(*SpannerOptions) error {
+	return slices.Clone(builder.Opts)
+}
+
+// NewSpannerOptions creates and returns a new instance of SpannerOptionsBuilder.
+// This function provides a convenient way to initialize the builder for creating Spanner configuration options.
+//
+// Returns:
+//   - *SpannerOptionsBuilder: A new instance of SpannerOptionsBuilder ready to be configured
+//
+// Example:
+//
+//	builder := NewSpannerOptions()
+//	config, err := NewSpannerConfig(builder.SetProject("my-project").SetInstance("main").SetDatabase("orders"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Spanner Config: %+v\n", config)
+func NewSpannerOptions() *SpannerOptionsBuilder {
+	return &SpannerOptionsBuilder{}
+}
+
+// SetProject configures the Google Cloud project of the Spanner database.
+// It appends an option function that sets the Project field of SpannerOptions.
+//
+// Parameters:
+//   - project: The Google Cloud project ID
+//
+// Returns:
+//   - *SpannerOptionsBuilder: The builder instance for method chaining
+func (builder *SpannerOptionsBuilder) SetProject(project string) *SpannerOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *SpannerOptions) error {
+		args.Project = project
+		return nil
+	})
+	return builder
+}
+
+// SetInstance configures the Spanner instance of the database.
+// It appends an option function that sets the Instance field of SpannerOptions.
+//
+// Parameters:
+//   - instance: The Spanner instance ID
+//
+// Returns:
+//   - *SpannerOptionsBuilder: The builder instance for method chaining
+func (builder *SpannerOptionsBuilder) SetInstance(instance string) *SpannerOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *SpannerOptions) error {
+		args.Instance = instance
+		return nil
+	})
+	return builder
+}
+
+// SetDatabase configures the Spanner database.
+// It appends an option function that sets the Database field of SpannerOptions.
+//
+// Parameters:
+//   - database: The Spanner database ID
+//
+// Returns:
+//   - *SpannerOptionsBuilder: The builder instance for method chaining
+func (builder *SpannerOptionsBuilder) SetDatabase(database string) *SpannerOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *SpannerOptions) error {
+		args.Database = database
+		return nil
+	})
+	return builder
+}
+
+// SetCredentialsFile configures the service account credentials file used to authenticate.
+// It appends an option function that sets the CredentialsFile field of SpannerOptions.
+// When unset, the client falls back to Application Default Credentials.
+//
+// Parameters:
+//   - credentialsFile: The path of the service account credentials file
+//
+// Returns:
+//   - *SpannerOptionsBuilder: The builder instance for method chaining
+func (builder *SpannerOptionsBuilder) SetCredentialsFile(credentialsFile string) *SpannerOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *SpannerOptions) error {
+		args.CredentialsFile = credentialsFile
+		return nil
+	})
+	return builder
+}
+
+// SetTrimSpace configures whether leading and trailing white space is trimmed from the string fields before validation.
+// It appends an option function that sets the TrimSpace field of SpannerOptions.
+// Trimming is off by default so that values are used exactly as given; enabling it guards against
+// a project or database ID copied with surrounding spaces silently breaking the configuration.
+//
+// Parameters:
+//   - trim: A flag indicating whether the string fields are trimmed
+//
+// Returns:
+//   - *SpannerOptionsBuilder: The builder instance for method chaining
+func (builder *SpannerOptionsBuilder) SetTrimSpace(trim bool) *SpannerOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *SpannerOptions) error {
+		args.TrimSpace = trim
+		return nil
+	})
+	return builder
+}
+
+// SpannerConfig represents the final Spanner configuration used for establishing connections.
+// This struct is created from SpannerOptions after validation and contains all the necessary
+// parameters for connecting to a Spanner database.
+type SpannerConfig struct {
+	Project         string            // Project is the Google Cloud project ID (e.g., "my-project").
+	Instance        string            // Instance is the Spanner instance ID.
+	Database        string            // Database is the Spanner database ID.
+	CredentialsFile string            // CredentialsFile is the optional path of a service account credentials file.
+	Extra           map[string]string // Extra holds backend-specific flags without a dedicated field yet; unknown keys are preserved.
+}
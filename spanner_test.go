@@ -0,0 +1,38 @@
+package alex
+
+import "testing"
+
+func TestSpannerConfigDatabasePath(t *testing.T) {
+	config, err := NewSpannerConfig(NewSpannerOptions().
+		SetProject("my-project").
+		SetInstance("main").
+		SetDatabase("orders").
+		SetCredentialsFile("/etc/gcp/key.json"))
+	if err != nil {
+		t.Fatalf("NewSpannerConfig() error = %v", err)
+	}
+	if got, want := config.DatabasePath(), "projects/my-project/instances/main/databases/orders"; got != want {
+		t.Errorf("DatabasePath() = %q, want %q", got, want)
+	}
+	if config.CredentialsFile != "/etc/gcp/key.json" {
+		t.Errorf("CredentialsFile = %q, want /etc/gcp/key.json", config.CredentialsFile)
+	}
+}
+
+func TestNewSpannerConfigRequiredFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *SpannerOptionsBuilder
+		field   string
+	}{
+		{name: "project", builder: NewSpannerOptions().SetInstance("main").SetDatabase("orders"), field: "Project"},
+		{name: "instance", builder: NewSpannerOptions().SetProject("my-project").SetDatabase("orders"), field: "Instance"},
+		{name: "database", builder: NewSpannerOptions().SetProject("my-project").SetInstance("main"), field: "Database"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSpannerConfig(tt.builder)
+			requireValidationError(t, err, tt.field, RuleRequired)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package alex
+
+import (
+	"errors"
+	"fmt"
+)
+
+// NewStoragePair builds the Redis and Minio halves of a StoragePair together.
+// Both halves are always built, so that the returned error reports every invalid half at once;
+// the pair is returned only when both are valid, never partially.
+//
+// Parameters:
+//   - options: The builders of both halves
+//
+// Returns:
+//   - *StoragePair: A pointer to the final storage pair configuration instance
+//   - error: The joined errors of the halves that failed to build, each prefixed with its backend name
+//
+// Example:
+//
+//	options := NewStoragePairOptions()
+//	options.Redis.SetAddr("localhost:6379")
+//	options.Minio.SetEndpoint("minio.example.com").SetAccessKey("accessKey").SetSecretKey("secretKey").SetBucketName("blobs")
+//	pair, err := NewStoragePair(options)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewStoragePair(options *StoragePairOptions) (*StoragePair, error) {
+	if options == nil || options.Redis == nil || options.Minio == nil {
+		return nil, errors.New("storage pair options must hold both a redis and a minio builder")
+	}
+	var errs []error
+	redisConfig, err := NewRedisConfig(options.Redis)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("storage pair redis: %w", err))
+	}
+	minioConfig, err := NewMinioConfig(options.Minio)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("storage pair minio: %w", err))
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return &StoragePair{Redis: redisConfig, Minio: minioConfig}, nil
+}
@@ -0,0 +1,37 @@
+package alex
+
+// StoragePairOptions holds the builders of a combined cache and storage setup, where Redis
+// keeps metadata and Minio keeps blobs. Both builders are configured directly and built together
+// by NewStoragePair.
+type StoragePairOptions struct {
+	Redis *RedisConfigOptionsBuilder // Redis is the builder of the Redis (metadata) half.
+	Minio *MinioOptionBuilder        // Minio is the builder of the Minio (blob) half.
+}
+
+// NewStoragePairOptions creates and returns a new instance of StoragePairOptions with empty Redis and Minio builders.
+//
+// Returns:
+//   - *StoragePairOptions: A new instance of StoragePairOptions ready to be configured
+//
+// Example:
+//
+//	options := NewStoragePairOptions()
+//	options.Redis.SetAddr("localhost:6379")
+//	options.Minio.SetEndpoint("minio.example.com").SetAccessKey("accessKey").SetSecretKey("secretKey").SetBucketName("blobs")
+//	pair, err := NewStoragePair(options)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewStoragePairOptions() *StoragePairOptions {
+	return &StoragePairOptions{
+		Redis: NewRedisConfigOptions(),
+		Minio: NewMinioOption(),
+	}
+}
+
+// StoragePair represents the final configuration of a combined cache and storage setup.
+// This struct is created from StoragePairOptions only when both halves are valid.
+type StoragePair struct {
+	Redis *RedisConfig // Redis is the Redis (metadata) configuration.
+	Minio *MinioConfig // Minio is the Minio (blob) configuration.
+}
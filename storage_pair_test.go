@@ -0,0 +1,77 @@
+package alex
+
+import (
+	"strings"
+	"testing"
+)
+
+// newTestStoragePairOptions returns storage pair builders holding valid Redis and Minio halves.
+func newTestStoragePairOptions() *StoragePairOptions {
+	options := NewStoragePairOptions()
+	options.Redis.SetAddr("localhost:6379")
+	options.Minio.SetEndpoint("localhost:9000").SetAccessKey("minioadmin").SetSecretKey("minioadmin").SetBucketName("blobs")
+	return options
+}
+
+func TestNewStoragePair(t *testing.T) {
+	pair, err := NewStoragePair(newTestStoragePairOptions())
+	if err != nil {
+		t.Fatalf("NewStoragePair() error = %v", err)
+	}
+	if pair.Redis.Addr != "localhost:6379" || pair.Minio.BucketName != "blobs" {
+		t.Errorf("pair = %s/%s, want localhost:6379/blobs", pair.Redis.Addr, pair.Minio.BucketName)
+	}
+}
+
+func TestNewStoragePairInvalidHalves(t *testing.T) {
+	tests := []struct {
+		name      string
+		breakHalf func(*StoragePairOptions)
+		want      []string
+		notWant   []string
+	}{
+		{
+			name:      "redis",
+			breakHalf: func(o *StoragePairOptions) { o.Redis.SetDB(-1) },
+			want:      []string{"storage pair redis:"},
+			notWant:   []string{"storage pair minio:"},
+		},
+		{
+			name:      "minio",
+			breakHalf: func(o *StoragePairOptions) { o.Minio.SetBucketName("") },
+			want:      []string{"storage pair minio:"},
+			notWant:   []string{"storage pair redis:"},
+		},
+		{
+			name: "both",
+			breakHalf: func(o *StoragePairOptions) {
+				o.Redis.SetDB(-1)
+				o.Minio.SetBucketName("")
+			},
+			want: []string{"storage pair redis:", "storage pair minio:"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := newTestStoragePairOptions()
+			tt.breakHalf(options)
+			pair, err := NewStoragePair(options)
+			if pair != nil || err == nil {
+				t.Fatalf("NewStoragePair() = %v, %v, want no pair and an error", pair, err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error = %q, want it to contain %q", err, want)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(err.Error(), notWant) {
+					t.Errorf("error = %q, want it not to contain %q", err, notWant)
+				}
+			}
+		})
+	}
+	if _, err := NewStoragePair(nil); err == nil {
+		t.Error("NewStoragePair(nil) error = nil, want an error")
+	}
+}
@@ -0,0 +1,80 @@
+package alex
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// Validate checks that the TLS options are consistent. It performs no I/O, so missing or
+// malformed files are only reported by NewTLSConfig.
+//
+// Returns:
+//   - error: A *ValidationError describing the first rule the options violate, or nil if they are valid
+func (o *TLSOptions) Validate() error {
+	if err := requireIf(o.CertFile != "", o.KeyFile, "KeyFile", "tls key file is required when a cert file is set"); err != nil {
+		return err
+	}
+	if err := requireIf(o.KeyFile != "", o.CertFile, "CertFile", "tls cert file is required when a key file is set"); err != nil {
+		return err
+	}
+	if o.InsecureSkipVerify && o.CAFile != "" {
+		return newValidationError("CAFile", RuleConflict, "tls ca file cannot be set when insecure skip verify is enabled")
+	}
+	switch o.MinVersion {
+	case 0, tls.VersionTLS10, tls.VersionTLS11, tls.VersionTLS12, tls.VersionTLS13:
+	default:
+		return newValidationError("MinVersion", RuleFormat, fmt.Sprintf("tls min version %#04x is not a known TLS version", o.MinVersion))
+	}
+	return nil
+}
+
+// NewTLSConfig validates the options and loads the TLS material they reference into a new *tls.Config.
+// The client certificate is loaded from CertFile and KeyFile, and the CA bundle from CAFile replaces
+// the system pool as the set of trusted roots. A nil receiver returns a nil configuration, which
+// disables TLS in the backends.
+//
+// Returns:
+//   - *tls.Config: The loaded TLS configuration
+//   - error: An error if validation fails, a file cannot be read, or it contains no usable PEM data
+//
+// Example:
+//
+//	options := &TLSOptions{CAFile: "/etc/tls/ca.crt", MinVersion: tls.VersionTLS12}
+//	tlsConfig, err := options.NewTLSConfig()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (o *TLSOptions) NewTLSConfig() (*tls.Config, error) {
+	if o == nil {
+		return nil, nil
+	}
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		ServerName:         o.ServerName,
+		InsecureSkipVerify: o.InsecureSkipVerify,
+		MinVersion:         o.MinVersion,
+	}
+	if o.CertFile != "" {
+		certificate, err := tls.LoadX509KeyPair(o.CertFile, o.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("tls key pair %s could not be loaded: %w", o.CertFile, err)
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+	if o.CAFile != "" {
+		data, err := os.ReadFile(o.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("tls ca file %s could not be read: %w", o.CAFile, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("tls ca file %s contains no PEM certificates", o.CAFile)
+		}
+		config.RootCAs = pool
+	}
+	return config, nil
+}
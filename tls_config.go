@@ -0,0 +1,185 @@
+package alex
+
+import "slices"
+
+// TLSOptions describes the TLS material shared by the backends: the client certificate and key,
+// the CA bundle used to verify the server, and the verification settings.
+// It is embedded by the backend configurations so Redis and Minio load certificates the same way,
+// and turned into a *tls.Config with NewTLSConfig.
+type TLSOptions struct {
+	CertFile           string // CertFile is the path of the PEM-encoded client certificate (requires KeyFile).
+	KeyFile            string // KeyFile is the path of the PEM-encoded client private key (requires CertFile).
+	CAFile             string // CAFile is the path of the PEM-encoded CA bundle verifying the server (empty uses the system pool).
+	InsecureSkipVerify bool   // InsecureSkipVerify is a flag disabling server certificate verification (conflicts with CAFile).
+	ServerName         string // ServerName overrides the server name verified against the certificate.
+	MinVersion         uint16 // MinVersion is the minimum TLS version, such as tls.VersionTLS12 (0 uses the crypto/tls default).
+}
+
+// TLSOptionsBuilder provides a builder pattern for constructing TLSOptions.
+// It accumulates option functions that can be applied to configure a TLSOptions instance.
+// This builder implements the builderutil.Lister interface to work with the functional options pattern.
+type TLSOptionsBuilder struct {
+	Opts []func(*TLSOptions) error // Opts contains the list of option functions to be applied
+}
+
+// List returns the slice of option functions accumulated by the builder.
+// This method implements the builderutil.Lister interface, allowing the builder
+// to be used with the builderutil.Build function.
+// The functions are returned in the order the setters were called and builderutil.Build applies them
+// in that order, so when several setters touch the same field the last call wins. The returned slice
+// is a copy, so callers cannot reorder or drop the queued options.
+//
+// Returns:
+//   - []func(*TLSOptions) error: A slice of option functions that can be applied to configure TLSOptions
+func (builder *TLSOptionsBuilder) List() []func(*TLSOptions) error {
+	return slices.Clone(builder.Opts)
+}
+
+// NewTLSOptions creates and returns a new instance of TLSOptionsBuilder.
+// This function provides a convenient way to initialize the builder for creating TLS options,
+// which are passed to the SetTLSOptions setter of a backend builder.
+//
+// Returns:
+//   - *TLSOptionsBuilder: A new instance of TLSOptionsBuilder ready to be configured
+//
+// Example:
+//
+//	config, err := NewRedisConfig(NewRedisConfigOptions().
+//	    SetAddr("redis.internal:6380").
+//	    SetTLSOptions(NewTLSOptions().
+//	        SetCertFile("/etc/tls/client.crt").
+//	        SetKeyFile("/etc/tls/client.key").
+//	        SetCAFile("/etc/tls/ca.crt")))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewTLSOptions() *TLSOptionsBuilder {
+	return &TLSOptionsBuilder{}
+}
+
+// SetCertFile configures the path of the PEM-encoded client certificate.
+// It appends an option function that sets the CertFile field of TLSOptions.
+//
+// Parameters:
+//   - certFile: The path of the client certificate (requires SetKeyFile)
+//
+// Returns:
+//   - *TLSOptionsBuilder: The builder instance for method chaining
+func (builder *TLSOptionsBuilder) SetCertFile(certFile string) *TLSOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *TLSOptions) error {
+		args.CertFile = certFile
+		return nil
+	})
+	return builder
+}
+
+// SetKeyFile configures the path of the PEM-encoded client private key.
+// It appends an option function that sets the KeyFile field of TLSOptions.
+//
+// Parameters:
+//   - keyFile: The path of the client private key (requires SetCertFile)
+//
+// Returns:
+//   - *TLSOptionsBuilder: The builder instance for method chaining
+func (builder *TLSOptionsBuilder) SetKeyFile(keyFile string) *TLSOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *TLSOptions) error {
+		args.KeyFile = keyFile
+		return nil
+	})
+	return builder
+}
+
+// SetCAFile configures the path of the PEM-encoded CA bundle verifying the server certificate.
+// It appends an option function that sets the CAFile field of TLSOptions.
+//
+// Parameters:
+//   - caFile: The path of the CA bundle (conflicts with SetInsecureSkipVerify(true))
+//
+// Returns:
+//   - *TLSOptionsBuilder: The builder instance for method chaining
+func (builder *TLSOptionsBuilder) SetCAFile(caFile string) *TLSOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *TLSOptions) error {
+		args.CAFile = caFile
+		return nil
+	})
+	return builder
+}
+
+// SetInsecureSkipVerify configures whether the server certificate verification is disabled.
+// It appends an option function that sets the InsecureSkipVerify field of TLSOptions.
+// It should only be used in development, and cannot be combined with a CA file.
+//
+// Parameters:
+//   - skip: A flag indicating whether the server certificate verification is disabled
+//
+// Returns:
+//   - *TLSOptionsBuilder: The builder instance for method chaining
+func (builder *TLSOptionsBuilder) SetInsecureSkipVerify(skip bool) *TLSOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *TLSOptions) error {
+		args.InsecureSkipVerify = skip
+		return nil
+	})
+	return builder
+}
+
+// SetServerName configures the server name verified against the server certificate.
+// It appends an option function that sets the ServerName field of TLSOptions.
+//
+// Parameters:
+//   - serverName: The server name expected in the certificate (e.g., "redis.internal")
+//
+// Returns:
+//   - *TLSOptionsBuilder: The builder instance for method chaining
+func (builder *TLSOptionsBuilder) SetServerName(serverName string) *TLSOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *TLSOptions) error {
+		args.ServerName = serverName
+		return nil
+	})
+	return builder
+}
+
+// SetMinVersion configures the minimum TLS version accepted for the connection.
+// It appends an option function that sets the MinVersion field of TLSOptions.
+//
+// Parameters:
+//   - version: One of tls.VersionTLS10, tls.VersionTLS11, tls.VersionTLS12, or tls.VersionTLS13
+//
+// Returns:
+//   - *TLSOptionsBuilder: The builder instance for method chaining
+func (builder *TLSOptionsBuilder) SetMinVersion(version uint16) *TLSOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *TLSOptions) error {
+		args.MinVersion = version
+		return nil
+	})
+	return builder
+}
+
+// From seeds the builder from an already populated TLSOptions.
+// It enqueues the matching setter for each non-zero field of the given options.
+//
+// Parameters:
+//   - o: The options whose non-zero fields should be applied
+//
+// Returns:
+//   - *TLSOptionsBuilder: The builder instance for method chaining
+func (builder *TLSOptionsBuilder) From(o TLSOptions) *TLSOptionsBuilder {
+	if o.CertFile != "" {
+		builder.SetCertFile(o.CertFile)
+	}
+	if o.KeyFile != "" {
+		builder.SetKeyFile(o.KeyFile)
+	}
+	if o.CAFile != "" {
+		builder.SetCAFile(o.CAFile)
+	}
+	if o.InsecureSkipVerify {
+		builder.SetInsecureSkipVerify(o.InsecureSkipVerify)
+	}
+	if o.ServerName != "" {
+		builder.SetServerName(o.ServerName)
+	}
+	if o.MinVersion != 0 {
+		builder.SetMinVersion(o.MinVersion)
+	}
+	return builder
+}
@@ -0,0 +1,123 @@
+package alex
+
+import (
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"path/filepath"
+	"testing"
+
+	"github.com/zeroxsolutions/strike/builderutil"
+)
+
+// writeTestTLSFiles writes a self-signed certificate, its private key, and a CA bundle holding the
+// certificate as PEM files, and returns their paths.
+func writeTestTLSFiles(t *testing.T) (certFile, keyFile, caFile string) {
+	t.Helper()
+	cert := newTestCertificate(t)
+	key, err := x509.MarshalECPrivateKey(cert.PrivateKey.(*ecdsa.PrivateKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}))
+	certFile = writeTempFile(t, "client.crt", certPEM)
+	keyFile = writeTempFile(t, "client.key", string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: key})))
+	caFile = writeTempFile(t, "ca.crt", certPEM)
+	return certFile, keyFile, caFile
+}
+
+func TestTLSOptionsNewTLSConfig(t *testing.T) {
+	certFile, keyFile, caFile := writeTestTLSFiles(t)
+	options, err := builderutil.Build[TLSOptions](NewTLSOptions().
+		SetCertFile(certFile).
+		SetKeyFile(keyFile).
+		SetCAFile(caFile).
+		SetServerName("redis.internal").
+		SetMinVersion(tls.VersionTLS12))
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := options.NewTLSConfig()
+	if err != nil {
+		t.Fatalf("NewTLSConfig() error = %v", err)
+	}
+	if len(config.Certificates) != 1 || config.RootCAs == nil {
+		t.Errorf("NewTLSConfig() = %d certificates, RootCAs %v, want the key pair and the CA pool", len(config.Certificates), config.RootCAs)
+	}
+	if config.ServerName != "redis.internal" || config.MinVersion != tls.VersionTLS12 || config.InsecureSkipVerify {
+		t.Errorf("NewTLSConfig() = %+v, want ServerName redis.internal and TLS 1.2", config)
+	}
+
+	if config, err := (*TLSOptions)(nil).NewTLSConfig(); config != nil || err != nil {
+		t.Errorf("NewTLSConfig() on nil = %v, %v, want nil, nil", config, err)
+	}
+}
+
+func TestTLSOptionsNewTLSConfigErrors(t *testing.T) {
+	certFile, keyFile, _ := writeTestTLSFiles(t)
+	notPEM := writeTempFile(t, "ca.txt", "not a certificate")
+	missing := filepath.Join(t.TempDir(), "missing.crt")
+	tests := []struct {
+		name    string
+		options TLSOptions
+	}{
+		{name: "missing_cert", options: TLSOptions{CertFile: missing, KeyFile: keyFile}},
+		{name: "mismatched_pair", options: TLSOptions{CertFile: keyFile, KeyFile: certFile}},
+		{name: "missing_ca", options: TLSOptions{CAFile: missing}},
+		{name: "ca_without_pem", options: TLSOptions{CAFile: notPEM}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if config, err := tt.options.NewTLSConfig(); err == nil {
+				t.Errorf("NewTLSConfig() = %+v, want an error", config)
+			}
+		})
+	}
+}
+
+func TestTLSOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		options TLSOptions
+		field   string
+		rule    string
+	}{
+		{name: "skip_verify_with_ca", options: TLSOptions{InsecureSkipVerify: true, CAFile: "/etc/tls/ca.crt"}, field: "CAFile", rule: RuleConflict},
+		{name: "cert_without_key", options: TLSOptions{CertFile: "/etc/tls/client.crt"}, field: "KeyFile", rule: RuleRequired},
+		{name: "key_without_cert", options: TLSOptions{KeyFile: "/etc/tls/client.key"}, field: "CertFile", rule: RuleRequired},
+		{name: "unknown_min_version", options: TLSOptions{MinVersion: 0x0305}, field: "MinVersion", rule: RuleFormat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireValidationError(t, tt.options.Validate(), tt.field, tt.rule)
+		})
+	}
+	if err := (&TLSOptions{InsecureSkipVerify: true, MinVersion: tls.VersionTLS13}).Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want skip-verify without a CA file to be valid", err)
+	}
+}
+
+func TestBackendsSetTLSOptions(t *testing.T) {
+	_, _, caFile := writeTestTLSFiles(t)
+	redis, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("redis.internal:6380").SetTLSOptions(NewTLSOptions().SetCAFile(caFile)))
+	if err != nil {
+		t.Fatalf("NewRedisConfig() error = %v", err)
+	}
+	if redis.TLSConfig == nil || redis.TLSConfig.RootCAs == nil || redis.TLSOptions == nil || redis.TLSOptions.CAFile != caFile {
+		t.Errorf("Redis TLSConfig, TLSOptions = %v, %+v, want the loaded CA file", redis.TLSConfig, redis.TLSOptions)
+	}
+	minio, err := NewMinioConfig(newTestMinioOptions().SetUseSSL(true).SetTLSOptions(NewTLSOptions().SetCAFile(caFile)))
+	if err != nil {
+		t.Fatalf("NewMinioConfig() error = %v", err)
+	}
+	if minio.TLSConfig == nil || minio.TLSConfig.RootCAs == nil || minio.TLSOptions == nil {
+		t.Errorf("Minio TLSConfig, TLSOptions = %v, %+v, want the loaded CA file", minio.TLSConfig, minio.TLSOptions)
+	}
+
+	conflict := NewTLSOptions().SetCAFile(caFile).SetInsecureSkipVerify(true)
+	_, err = NewRedisConfig(NewRedisConfigOptions().SetAddr("redis.internal:6380").SetTLSOptions(conflict))
+	requireValidationError(t, err, "TLSOptions.CAFile", RuleConflict)
+	_, err = NewMinioConfig(newTestMinioOptions().SetTLSOptions(conflict))
+	requireValidationError(t, err, "TLSOptions.CAFile", RuleConflict)
+}
@@ -0,0 +1,79 @@
+package alex
+
+import "fmt"
+
+// validateHostPort checks that addr is a valid "host:port" network address.
+// It relies on ParseAddress, so bracketed IPv6 literals such as "[::1]:6379" are accepted,
+// while a bare IPv6 address such as "::1" or a non-numeric port is rejected.
+//
+// Parameters:
+//   - addr: The network address to validate
+//   - field: The path of the validated field, reported in the ValidationError (e.g., "Addr")
+//   - label: The human readable name of the field, used as the error message prefix (e.g., "redis address")
+//
+// Returns:
+//   - error: A *ValidationError if the address cannot be parsed into a host and a valid port
+func validateHostPort(addr, field, label string) error {
+	if _, err := ParseAddress(addr); err != nil {
+		return newValidationError(field, RuleFormat, fmt.Sprintf("%s %q is invalid: %v", label, addr, err))
+	}
+	return nil
+}
+
+// validateHostPorts checks that addrs holds at least one address and that each entry is a valid "host:port".
+// The error for an invalid entry names both its index and its value, so callers validating
+// node lists (Sentinel, Cluster, etc.) can share the same rules and messages.
+//
+// Parameters:
+//   - addrs: The network addresses to validate
+//   - field: The path of the validated field, reported in the ValidationError (e.g., "SentinelAddrs")
+//   - label: The human readable name of a single entry, used as the error message prefix (e.g., "redis sentinel address")
+//
+// Returns:
+//   - error: A *ValidationError if the list is empty or any entry is not a valid "host:port"
+func validateHostPorts(addrs []string, field, label string) error {
+	if len(addrs) == 0 {
+		return newValidationError(field, RuleRequired, fmt.Sprintf("at least one %s is required", label))
+	}
+	for i, addr := range addrs {
+		index := fmt.Sprintf("[%d]", i)
+		if err := validateHostPort(addr, field+index, label+index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// requireIf reports a RuleRequired error when cond holds and value is empty, expressing
+// conditional requirements (e.g., an exchange name required once an exchange type is set) uniformly.
+//
+// Parameters:
+//   - cond: The condition under which value is required
+//   - value: The value that must be non-empty when cond holds
+//   - field: The path of the validated field, reported in the ValidationError (e.g., "Exchange")
+//   - reason: The error message, phrased as "<backend> <field> is required when <condition>"
+//     (e.g., "rabbitmq exchange is required when an exchange type is set")
+//
+// Returns:
+//   - error: A *ValidationError if cond holds and value is empty, or nil otherwise
+func requireIf(cond bool, value, field, reason string) error {
+	if cond && value == "" {
+		return newValidationError(field, RuleRequired, reason)
+	}
+	return nil
+}
+
+// validateExtra checks that every key of an Extra map is non-empty.
+//
+// Parameters:
+//   - extra: The backend-specific flags to validate
+//   - label: The name of the backend, used as the error message prefix (e.g., "redis")
+//
+// Returns:
+//   - error: A *ValidationError if any key is empty, or nil otherwise
+func validateExtra(extra map[string]string, label string) error {
+	if _, ok := extra[""]; ok {
+		return newValidationError("Extra", RuleRequired, label+" extra key is required")
+	}
+	return nil
+}
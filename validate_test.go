@@ -0,0 +1,89 @@
+package alex
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateHostPort(t *testing.T) {
+	tests := []struct {
+		addr    string
+		wantErr bool
+	}{
+		{"[::1]:6379", false},
+		{"[2001:db8::1]:6380", false},
+		{"127.0.0.1:6379", false},
+		{"localhost:6379", false},
+		{"::1", true},
+		{"[::1]", true},
+		{"localhost", true},
+		{"localhost:", true},
+		{"localhost:abc", true},
+		{"localhost:70000", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			err := validateHostPort(tt.addr, "Addr", "redis address")
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("validateHostPort(%q) error = %v", tt.addr, err)
+				}
+				return
+			}
+			requireValidationError(t, err, "Addr", RuleFormat)
+		})
+	}
+}
+
+func TestNewRedisConfigIPv6Addr(t *testing.T) {
+	if _, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("[::1]:6379")); err != nil {
+		t.Fatalf("NewRedisConfig([::1]:6379) error = %v", err)
+	}
+	_, err := NewRedisConfig(NewRedisConfigOptions().SetAddr("::1"))
+	requireValidationError(t, err, "Addr", RuleFormat)
+}
+
+func TestValidateHostPorts(t *testing.T) {
+	err := validateHostPorts(nil, "SentinelAddrs", "redis sentinel address")
+	requireValidationError(t, err, "SentinelAddrs", RuleRequired)
+
+	err = validateHostPorts([]string{"host1:26379", "host2", "host3:26379"}, "SentinelAddrs", "redis sentinel address")
+	ve := requireValidationError(t, err, "SentinelAddrs[1]", RuleFormat)
+	if !strings.Contains(ve.Message, "[1]") || !strings.Contains(ve.Message, `"host2"`) {
+		t.Errorf("message = %q, want it to name the index and the offending entry", ve.Message)
+	}
+
+	if err := validateHostPorts([]string{"[::1]:26379", "[2001:db8::2]:26379", "10.0.0.1:26379"}, "SentinelAddrs", "redis sentinel address"); err != nil {
+		t.Errorf("validateHostPorts(IPv6) error = %v", err)
+	}
+}
+
+func TestRequireIf(t *testing.T) {
+	const reason = "minio region is required when create-bucket is enabled"
+	tests := []struct {
+		name    string
+		cond    bool
+		value   string
+		wantErr bool
+	}{
+		{name: "condition_and_value", cond: true, value: "us-east-1", wantErr: false},
+		{name: "condition_without_value", cond: true, value: "", wantErr: true},
+		{name: "no_condition_without_value", cond: false, value: "", wantErr: false},
+		{name: "no_condition_with_value", cond: false, value: "us-east-1", wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := requireIf(tt.cond, tt.value, "Region", reason)
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("requireIf() error = %v, want nil", err)
+				}
+				return
+			}
+			ve := requireValidationError(t, err, "Region", RuleRequired)
+			if ve.Error() != reason {
+				t.Errorf("requireIf() error = %q, want %q", ve.Error(), reason)
+			}
+		})
+	}
+}
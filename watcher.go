@@ -0,0 +1,94 @@
+package alex
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+)
+
+// DefaultWatchInterval is the polling interval used by a FileWatcher when Interval is not set.
+const DefaultWatchInterval = TimeoutDefault * time.Second
+
+// Watcher detects changes in a configuration source.
+// Consumers rebuild their configs each time a signal is received on the returned channel.
+type Watcher interface {
+	// Watch starts watching the source and returns a channel that receives a signal on every change.
+	// The channel is closed when ctx is done.
+	Watch(ctx context.Context) (<-chan struct{}, error)
+}
+
+// FileWatcher is a Watcher that polls a configuration file and signals when its
+// modification time or size changes.
+type FileWatcher struct {
+	Path     string        // Path is the path of the watched configuration file.
+	Interval time.Duration // Interval is the polling interval (defaults to DefaultWatchInterval).
+}
+
+// NewFileWatcher creates and returns a new FileWatcher for the given file path.
+//
+// Parameters:
+//   - path: The path of the configuration file to watch
+//
+// Returns:
+//   - *FileWatcher: A new FileWatcher polling at DefaultWatchInterval
+//
+// Example:
+//
+//	changes, err := NewFileWatcher("config.json").Watch(ctx)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for range changes {
+//	    // rebuild configs
+//	}
+func NewFileWatcher(path string) *FileWatcher {
+	return &FileWatcher{Path: path, Interval: DefaultWatchInterval}
+}
+
+// Watch starts polling the file and returns a channel that receives a signal each time the file changes.
+// Signals are coalesced: if the consumer has not yet received a pending signal, further changes
+// do not queue additional ones. The channel is closed when ctx is done.
+//
+// Parameters:
+//   - ctx: The context controlling the lifetime of the watch
+//
+// Returns:
+//   - <-chan struct{}: A channel receiving a signal on every detected change
+//   - error: An error if the file cannot be read when the watch starts
+func (w *FileWatcher) Watch(ctx context.Context) (<-chan struct{}, error) {
+	if w.Path == "" {
+		return nil, errors.New("file watcher path is required")
+	}
+	last, err := os.Stat(w.Path)
+	if err != nil {
+		return nil, err
+	}
+	interval := w.Interval
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	changes := make(chan struct{}, 1)
+	go func() {
+		defer close(changes)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			info, err := os.Stat(w.Path)
+			if err != nil || (info.ModTime().Equal(last.ModTime()) && info.Size() == last.Size()) {
+				continue
+			}
+			last = info
+			select {
+			case changes <- struct{}{}:
+			default:
+			}
+		}
+	}()
+	return changes, nil
+}
@@ -0,0 +1,76 @@
+package alex
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var _ Watcher = (*FileWatcher)(nil)
+
+func TestFileWatcherSignalsOnChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"addr":"localhost:6379"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watcher := NewFileWatcher(path)
+	watcher.Interval = 10 * time.Millisecond
+	changes, err := watcher.Watch(ctx)
+	if err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"addr":"localhost:6380","db":1}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-changes:
+	case <-time.After(2 * time.Second):
+		t.Fatal("no signal after the file changed")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-changes:
+		if ok {
+			// A change may still be pending; the channel must close right after.
+			if _, ok := <-changes; ok {
+				t.Fatal("channel still open after the context was canceled")
+			}
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("channel not closed after the context was canceled")
+	}
+}
+
+func TestFileWatcherNoSignalWithoutChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watcher := &FileWatcher{Path: path, Interval: 10 * time.Millisecond}
+	changes, err := watcher.Watch(ctx)
+	if err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+	select {
+	case <-changes:
+		t.Fatal("signal received although the file did not change")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestFileWatcherMissingFile(t *testing.T) {
+	if _, err := NewFileWatcher(filepath.Join(t.TempDir(), "missing.json")).Watch(context.Background()); err == nil {
+		t.Error("Watch() error = nil, want an error for a missing file")
+	}
+	if _, err := (&FileWatcher{}).Watch(context.Background()); err == nil {
+		t.Error("Watch() error = nil, want an error for an empty path")
+	}
+}
@@ -0,0 +1,148 @@
+package alex
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/zeroxsolutions/strike/builderutil"
+)
+
+// NewWebhookConfig creates a new WebhookConfig from WebhookOptions by applying the provided option functions.
+// It uses the builderutil package to build the configuration options in a functional options pattern,
+// then creates and returns a final WebhookConfig instance. The method defaults to "POST".
+//
+// Validation rules:
+//   - URL is required and must use the "http" or "https" scheme with a host
+//   - Method must be "POST" or "PUT"
+//   - Timeout must be greater than or equal to 0
+//   - Header names must not be empty
+//
+// Parameters:
+//   - opts: Variable number of option functions that configure the WebhookOptions
+//
+// Returns:
+//   - *WebhookConfig: A pointer to the final webhook configuration instance
+//   - error: An error if the configuration building process fails or validation fails
+//
+// Example:
+//
+//	builder := NewWebhookOptions()
+//	config, err := NewWebhookConfig(builder.SetURL("https://hooks.example.com/events").SetSecret("secret").SetTimeout(5 * time.Second))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewWebhookConfig(opts ...builderutil.Lister[WebhookOptions]) (*WebhookConfig, error) {
+	options, err := builderutil.Build(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if options.TrimSpace {
+		trimSpace(&options.URL, &options.Secret, &options.Method)
+	}
+	if options.Secret, err = resolveSecret(context.Background(), options.Secret); err != nil {
+		return nil, err
+	}
+	config := &WebhookConfig{
+		URL:     options.URL,
+		Secret:  options.Secret,
+		Method:  options.Method,
+		Timeout: options.Timeout,
+		Headers: options.Headers,
+		Extra:   options.Extra,
+	}
+	if config.Method == "" {
+		config.Method = http.MethodPost
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	notifyBuild(config)
+	return config, nil
+}
+
+// Validate checks that the WebhookConfig satisfies the rules enforced by its constructor.
+// It performs no I/O, so it can be used to re-check a configuration at any time.
+//
+// Returns:
+//   - error: A *ValidationError describing the first rule the configuration violates, or nil if it is valid
+func (c *WebhookConfig) Validate() error {
+	if c.URL == "" {
+		return newValidationError("URL", RuleRequired, "webhook url is required")
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return newValidationError("URL", RuleFormat, "webhook url must be an http:// or https:// URL with a host")
+	}
+	switch c.Method {
+	case http.MethodPost, http.MethodPut:
+	default:
+		return newValidationError("Method", RuleFormat, fmt.Sprintf("webhook method %q must be POST or PUT", c.Method))
+	}
+	if c.Timeout < 0 {
+		return newValidationError("Timeout", RuleMin, "webhook timeout must be greater than or equal to 0")
+	}
+	if _, ok := c.Headers[""]; ok {
+		return newValidationError("Headers", RuleRequired, "webhook header name is required")
+	}
+	if err := validateExtra(c.Extra, "webhook"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Labels returns identifying, non-secret key/value pairs describing the configuration.
+// The URL is reduced to its host so that credentials and tokens in the path or query are never exposed.
+//
+// Returns:
+//   - map[string]string: The "kind", "host", and "method" labels
+func (c *WebhookConfig) Labels() map[string]string {
+	host := ""
+	if u, err := url.Parse(c.URL); err == nil {
+		host = u.Host
+	}
+	return map[string]string{
+		"kind":   "webhook",
+		"host":   host,
+		"method": c.Method,
+	}
+}
+
+// LogValue implements slog.LogValuer, so that logging the configuration with log/slog
+// (e.g., slog.Info("config", "webhook", cfg)) emits a group of its main fields.
+// The signing secret and any password embedded in the URL are redacted, and the headers, which may
+// carry credentials, are left out. Extra flags are not logged.
+//
+// Returns:
+//   - slog.Value: A group value holding the logged fields
+func (c *WebhookConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("url", redactURL(c.URL)),
+		slog.String("method", c.Method),
+		slog.Duration("timeout", c.Timeout),
+		secretAttr("secret", c.Secret),
+	)
+}
+
+// SignPayload computes the HMAC-SHA256 signature of body keyed with Secret, for the receiver
+// to verify that the payload was sent by the holder of the secret and was not altered.
+//
+// Parameters:
+//   - body: The request body to sign
+//
+// Returns:
+//   - string: The hex-encoded signature
+//
+// Example:
+//
+//	req.Header.Set("X-Signature", config.SignPayload(body))
+func (c *WebhookConfig) SignPayload(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(c.Secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,193 @@
+package alex
+
+import (
+	"slices"
+	"time"
+)
+
+// WebhookOptions represents the configuration options for a webhook (callback) endpoint.
+// It includes the target URL, the HTTP method and headers of the request, its timeout,
+// and the secret used to sign the payload.
+type WebhookOptions struct {
+	URL       string            // URL is the endpoint the webhook is posted to (e.g., "https://hooks.example.com/events").
+	Secret    string            // Secret is the shared key used to sign the payload with HMAC-SHA256.
+	Method    string            // Method is the HTTP method of the request: "POST" (default) or "PUT".
+	Timeout   time.Duration     // Timeout is the request timeout (0 uses the client default).
+	Headers   map[string]string // Headers are the extra HTTP headers sent with the request.
+	Extra     map[string]string // Extra holds backend-specific flags without a dedicated field yet; unknown keys are preserved.
+	TrimSpace bool              // TrimSpace is a flag indicating whether the constructor trims leading and trailing white space from the string fields.
+}
+
+// WebhookOptionsBuilder provides a builder pattern for constructing WebhookOptions.
+// It accumulates option functions that can be applied to configure a WebhookOptions instance.
+// This builder implements the builderutil.Lister interface to work with the functional options pattern.
+type WebhookOptionsBuilder struct {
+	Opts []func(*WebhookOptions) error // Opts contains the list of option functions to be applied
+}
+
+// SetExtra sets a backend-specific flag that has no dedicated field yet, as a forward-compatible escape hatch.
+// It appends an option function that adds the key/value pair to the Extra field of WebhookOptions.
+// The constructor does not interpret the flags but preserves them in the final configuration.
+//
+// Parameters:
+//   - key: The flag name (must not be empty); setting an existing key replaces its value
+//   - value: The flag value
+//
+// Returns:
+//   - *WebhookOptionsBuilder: The builder instance for method chaining
+func (builder *WebhookOptionsBuilder) SetExtra(key, value string) *WebhookOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *WebhookOptions) error {
+		if args.Extra == nil {
+			args.Extra = make(map[string]string)
+		}
+		args.Extra[key] = value
+		return nil
+	})
+	return builder
+}
+
+// List returns the slice of option functions accumulated by the builder.
+// This method implements the builderutil.Lister interface, allowing the builder
+// to be used with the builderutil.Build function.
+// The functions are returned in the order the setters were called and builderutil.Build applies them
+// in that order, so when several setters touch the same field the last call wins. The returned slice
+// is a copy, so callers cannot reorder or drop the queued options.
+//
+// Returns:
+//   - []func(*WebhookOptions) error: A slice of option functions that can be applied to configure WebhookOptions
+func (builder *WebhookOptionsBuilder) List() []func(*WebhookOptions) error {
+	return slices.Clone(builder.Opts)
+}
+
+// NewWebhookOptions creates and returns a new instance of WebhookOptionsBuilder.
+// This function provides a convenient way to initialize the builder for creating webhook configuration options.
+//
+// Returns:
+//   - *WebhookOptionsBuilder: A new instance of WebhookOptionsBuilder ready to be configured
+//
+// Example:
+//
+//	builder := NewWebhookOptions()
+//	config, err := NewWebhookConfig(builder.SetURL("https://hooks.example.com/events").SetSecret("secret"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Webhook Config: %+v\n", config)
+func NewWebhookOptions() *WebhookOptionsBuilder {
+	return &WebhookOptionsBuilder{}
+}
+
+// SetURL configures the endpoint the webhook is posted to.
+// It appends an option function that sets the URL field of WebhookOptions.
+//
+// Parameters:
+//   - url: The http:// or https:// URL of the endpoint (e.g., "https://hooks.example.com/events")
+//
+// Returns:
+//   - *WebhookOptionsBuilder: The builder instance for method chaining
+func (builder *WebhookOptionsBuilder) SetURL(url string) *WebhookOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *WebhookOptions) error {
+		args.URL = url
+		return nil
+	})
+	return builder
+}
+
+// SetSecret configures the shared key used to sign the payload.
+// It appends an option function that sets the Secret field of WebhookOptions.
+// A "vault://path#key" reference is resolved at build time through the SecretResolver set with SetSecretResolver.
+//
+// Parameters:
+//   - secret: The shared signing key
+//
+// Returns:
+//   - *WebhookOptionsBuilder: The builder instance for method chaining
+func (builder *WebhookOptionsBuilder) SetSecret(secret string) *WebhookOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *WebhookOptions) error {
+		args.Secret = secret
+		return nil
+	})
+	return builder
+}
+
+// SetMethod configures the HTTP method of the webhook request.
+// It appends an option function that sets the Method field of WebhookOptions.
+//
+// Parameters:
+//   - method: The HTTP method: "POST" or "PUT"
+//
+// Returns:
+//   - *WebhookOptionsBuilder: The builder instance for method chaining
+func (builder *WebhookOptionsBuilder) SetMethod(method string) *WebhookOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *WebhookOptions) error {
+		args.Method = method
+		return nil
+	})
+	return builder
+}
+
+// SetTimeout configures the timeout of the webhook request.
+// It appends an option function that sets the Timeout field of WebhookOptions.
+//
+// Parameters:
+//   - timeout: The request timeout (0 uses the client default)
+//
+// Returns:
+//   - *WebhookOptionsBuilder: The builder instance for method chaining
+func (builder *WebhookOptionsBuilder) SetTimeout(timeout time.Duration) *WebhookOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *WebhookOptions) error {
+		args.Timeout = timeout
+		return nil
+	})
+	return builder
+}
+
+// SetHeader adds an HTTP header sent with the webhook request.
+// It appends an option function that adds the key/value pair to the Headers field of WebhookOptions.
+//
+// Parameters:
+//   - key: The header name (must not be empty); setting an existing header replaces its value
+//   - value: The header value
+//
+// Returns:
+//   - *WebhookOptionsBuilder: The builder instance for method chaining
+func (builder *WebhookOptionsBuilder) SetHeader(key, value string) *WebhookOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *WebhookOptions) error {
+		if args.Headers == nil {
+			args.Headers = make(map[string]string)
+		}
+		args.Headers[key] = value
+		return nil
+	})
+	return builder
+}
+
+// SetTrimSpace configures whether leading and trailing white space is trimmed from the string fields before validation.
+// It appends an option function that sets the TrimSpace field of WebhookOptions.
+// Trimming is off by default so that values are used exactly as given; enabling it guards against
+// a signing secret pasted with a trailing newline silently breaking the configuration.
+//
+// Parameters:
+//   - trim: A flag indicating whether the string fields are trimmed
+//
+// Returns:
+//   - *WebhookOptionsBuilder: The builder instance for method chaining
+func (builder *WebhookOptionsBuilder) SetTrimSpace(trim bool) *WebhookOptionsBuilder {
+	builder.Opts = append(builder.Opts, func(args *WebhookOptions) error {
+		args.TrimSpace = trim
+		return nil
+	})
+	return builder
+}
+
+// WebhookConfig represents the final webhook configuration used for posting callbacks.
+// This struct is created from WebhookOptions after validation and contains all the necessary
+// parameters for building and signing webhook requests.
+type WebhookConfig struct {
+	URL     string            // URL is the endpoint the webhook is posted to (e.g., "https://hooks.example.com/events").
+	Secret  string            // Secret is the shared key used to sign the payload with HMAC-SHA256.
+	Method  string            // Method is the HTTP method of the request: "POST" or "PUT".
+	Timeout time.Duration     // Timeout is the request timeout (0 uses the client default).
+	Headers map[string]string // Headers are the extra HTTP headers sent with the request.
+	Extra   map[string]string // Extra holds backend-specific flags without a dedicated field yet; unknown keys are preserved.
+}
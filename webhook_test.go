@@ -0,0 +1,55 @@
+package alex
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewWebhookConfigValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *WebhookOptionsBuilder
+		field   string
+		rule    string
+	}{
+		{name: "missing_url", builder: NewWebhookOptions(), field: "URL", rule: RuleRequired},
+		{name: "relative_url", builder: NewWebhookOptions().SetURL("/events"), field: "URL", rule: RuleFormat},
+		{name: "unsupported_scheme", builder: NewWebhookOptions().SetURL("ftp://hooks.example.com/events"), field: "URL", rule: RuleFormat},
+		{name: "missing_host", builder: NewWebhookOptions().SetURL("https:///events"), field: "URL", rule: RuleFormat},
+		{name: "unparsable_url", builder: NewWebhookOptions().SetURL("https://%zz"), field: "URL", rule: RuleFormat},
+		{name: "unsupported_method", builder: NewWebhookOptions().SetURL("https://hooks.example.com").SetMethod(http.MethodGet), field: "Method", rule: RuleFormat},
+		{name: "empty_header_name", builder: NewWebhookOptions().SetURL("https://hooks.example.com").SetHeader("", "value"), field: "Headers", rule: RuleRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWebhookConfig(tt.builder)
+			requireValidationError(t, err, tt.field, tt.rule)
+		})
+	}
+
+	config, err := NewWebhookConfig(NewWebhookOptions().SetURL("http://hooks.example.com/events"))
+	if err != nil {
+		t.Fatalf("NewWebhookConfig() error = %v", err)
+	}
+	if config.Method != http.MethodPost {
+		t.Errorf("Method = %q, want POST by default", config.Method)
+	}
+	if _, err := NewWebhookConfig(NewWebhookOptions().SetURL("https://hooks.example.com/events").SetMethod(http.MethodPut)); err != nil {
+		t.Errorf("NewWebhookConfig(PUT) error = %v", err)
+	}
+}
+
+func TestWebhookConfigSignPayload(t *testing.T) {
+	// Test case 2 of RFC 4231.
+	config := &WebhookConfig{Secret: "Jefe"}
+	if got, want := config.SignPayload([]byte("what do ya want for nothing?")), "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"; got != want {
+		t.Errorf("SignPayload() = %q, want %q", got, want)
+	}
+	if config.SignPayload([]byte("a")) == config.SignPayload([]byte("b")) {
+		t.Error("SignPayload() returned the same signature for different bodies")
+	}
+	other := &WebhookConfig{Secret: "other"}
+	if config.SignPayload([]byte("a")) == other.SignPayload([]byte("a")) {
+		t.Error("SignPayload() returned the same signature for different secrets")
+	}
+}